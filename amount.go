@@ -0,0 +1,156 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"fmt"
+	"math/big"
+	"unicode"
+)
+
+// Amount is a number of Wei along with the unit in which it was originally
+// supplied.
+type Amount struct {
+	wei     *big.Int
+	unitPos int
+}
+
+// ParseAmount turns a string in to an amount, remembering the unit in which
+// the value was supplied.
+// See StringToWei for details of the accepted input.
+func ParseAmount(input string) (*Amount, error) {
+	wei, unit, err := stringToWei(input, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return nil, err
+	}
+
+	// This will never fail because the unit has already been parsed.
+	unitPos, _ := unitToMetricPos(unit)
+
+	return &Amount{
+		wei:     wei,
+		unitPos: unitPos,
+	}, nil
+}
+
+// Wei returns the value of the amount.
+// The returned value belongs to the caller, who may modify it without
+// affecting the amount.
+func (a Amount) Wei() *big.Int {
+	if a.wei == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(a.wei)
+}
+
+// WithWei returns an amount with the given value and the unit of this amount,
+// e.g. to render a total in the unit in which a price was supplied.
+func (a Amount) WithWei(wei *big.Int) Amount {
+	if wei != nil {
+		wei = new(big.Int).Set(wei)
+	}
+
+	return Amount{
+		wei:     wei,
+		unitPos: a.unitPos,
+	}
+}
+
+// Reformat renders the amount canonically, ignoring the unit in which it was
+// originally supplied.
+// See WeiToString for details.
+func (a Amount) Reformat(standard bool) string {
+	return WeiToString(a.wei, standard)
+}
+
+// Original renders the current value of the amount in the unit in which it
+// was originally supplied.
+func (a Amount) Original() string {
+	return weiToUnitString(a.wei, a.unitPos)
+}
+
+// String implements fmt.Stringer, rendering the amount in the unit in which
+// it was originally supplied, e.g. "21 GWei" for an amount parsed from
+// "21 gwei".
+// See Original for details.
+func (a Amount) String() string {
+	return a.Original()
+}
+
+// Scan implements fmt.Scanner, allowing amounts to be read with fmt.Sscan and
+// similar functions using the %v and %s verbs, e.g.
+//
+//	var gasPrice string2eth.Amount
+//	fmt.Sscan("21 gwei", &gasPrice)
+//
+// Scan reads a number and, if present, the unit that follows it, separated by
+// spaces or tabs.  A word following the number is always treated as the unit,
+// so a number without a unit must be followed by other than a word or be the
+// last item in the input.
+// Hexadecimal values, scientific notation and symbols are not supported.
+// See ParseAmount for details.
+func (a *Amount) Scan(state fmt.ScanState, verb rune) error {
+	if verb != 'v' && verb != 's' {
+		return wrapError(ErrInvalidFormat, "unsupported verb %"+string(verb))
+	}
+
+	token, err := state.Token(true, isScanNumberRune)
+	if err != nil {
+		return err
+	}
+	if len(token) == 0 {
+		return ErrEmptyValue
+	}
+	// The token is only valid until the next read, so take a copy.
+	number := string(token)
+
+	unit := ""
+	for {
+		r, _, err := state.ReadRune()
+		if err != nil {
+			break
+		}
+		if r == ' ' || r == '\t' {
+			continue
+		}
+		if err := state.UnreadRune(); err != nil {
+			return err
+		}
+		if unicode.IsLetter(r) {
+			token, err := state.Token(false, unicode.IsLetter)
+			if err != nil {
+				return err
+			}
+			unit = string(token)
+		}
+
+		break
+	}
+
+	amount, err := ParseAmount(number + " " + unit)
+	if err != nil {
+		return err
+	}
+	*a = *amount
+
+	return nil
+}
+
+// isScanNumberRune returns true if the rune can be part of a number read by
+// Scan.
+func isScanNumberRune(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '.' || r == ',' || r == '_' || r == '-' || r == '+'
+}
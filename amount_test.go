@@ -0,0 +1,206 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestAmountOriginal(t *testing.T) {
+	amount, err := string2eth.ParseAmount("0.5 ether")
+	require.NoError(t, err)
+	require.Equal(t, "0.5 Ether", amount.Original())
+	require.Equal(t, "0.5 Ether", amount.Reformat(true))
+
+	// Modifying the returned value does not change the amount.
+	wei := amount.Wei()
+	wei.Add(wei, big.NewInt(1000000000))
+	require.Equal(t, "0.5 Ether", amount.Original())
+	require.Equal(t, _bigInt("500000000000000000"), amount.Wei())
+
+	// A new value retains the original unit.
+	modified := amount.WithWei(wei)
+	require.Equal(t, "0.500000001 Ether", modified.Original())
+	require.Equal(t, "0.500000001 Ether", modified.Reformat(true))
+
+	// The new value is copied.
+	wei.SetInt64(1000000000)
+	require.Equal(t, "0.500000001 Ether", modified.Original())
+
+	modified = amount.WithWei(big.NewInt(1000000000))
+	require.Equal(t, "0.000000001 Ether", modified.Original())
+	require.Equal(t, "1 GWei", modified.Reformat(true))
+
+	modified = amount.WithWei(big.NewInt(2000000000000000000))
+	require.Equal(t, "2 Ether", modified.Original())
+
+	modified = amount.WithWei(big.NewInt(0))
+	require.Equal(t, "0 Ether", modified.Original())
+	require.Equal(t, "0", modified.Reformat(true))
+}
+
+func TestAmountZeroValue(t *testing.T) {
+	var amount string2eth.Amount
+	require.Equal(t, big.NewInt(0), amount.Wei())
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		original string
+		err      string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:     "Wei",
+			input:    "12345",
+			original: "12345 Wei",
+		},
+		{
+			name:     "GivenName",
+			input:    "1.5 finney",
+			original: "1.5 Milliether",
+		},
+		{
+			name:     "GWei",
+			input:    "20gwei",
+			original: "20 GWei",
+		},
+		{
+			name:     "Teraether",
+			input:    "0.001 tera",
+			original: "0.001 Teraether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			amount, err := string2eth.ParseAmount(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.original, amount.Original())
+			}
+		})
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		output string
+	}{
+		{
+			name:   "GWei",
+			input:  "21 gwei",
+			output: "21 GWei",
+		},
+		{
+			name:   "Wei",
+			input:  "21000000000",
+			output: "21000000000 Wei",
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ETH",
+			output: "1.5 Ether",
+		},
+		{
+			name:   "GivenName",
+			input:  "2 szabo",
+			output: "2 Microether",
+		},
+		{
+			name:   "Fractional",
+			input:  "0.000001 kiloether",
+			output: "0.000001 Kiloether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			amount, err := string2eth.ParseAmount(test.input)
+			require.NoError(t, err)
+			require.Equal(t, test.output, amount.String())
+			require.Equal(t, test.output, fmt.Sprint(amount))
+			require.Equal(t, test.output, fmt.Sprintf("%v", *amount))
+
+			// The unit must survive a parse/format cycle.
+			reparsed, err := string2eth.ParseAmount(amount.String())
+			require.NoError(t, err)
+			require.Equal(t, amount.Wei(), reparsed.Wei())
+			require.Equal(t, test.output, reparsed.String())
+		})
+	}
+}
+
+func TestAmountScan(t *testing.T) {
+	var first, second string2eth.Amount
+	n, err := fmt.Sscan("1.5 ether 21 gwei", &first, &second)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, "1.5 Ether", first.Original())
+	require.Equal(t, "21 GWei", second.Original())
+
+	var name string
+	n, err = fmt.Sscan("alice 1.5 ether 21gwei", &name, &first, &second)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, "alice", name)
+	require.Equal(t, "1.5 Ether", first.Original())
+	require.Equal(t, "21 GWei", second.Original())
+
+	n, err = fmt.Sscanf("fee: 0.5 finney, to: bob", "fee: %v, to: %s", &first, &name)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, "0.5 Milliether", first.Original())
+	require.Equal(t, "bob", name)
+
+	var count int
+	n, err = fmt.Sscan("1000 42", &first, &count)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, "1000 Wei", first.Original())
+	require.Equal(t, 42, count)
+
+	n, err = fmt.Sscanln("1,000 ether\n2 gwei", &first)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, "1000 Ether", first.Original())
+
+	_, err = fmt.Sscan("1.5 foo", &first)
+	require.ErrorIs(t, err, string2eth.ErrParseFailure)
+
+	_, err = fmt.Sscan("-1 ether", &first)
+	require.ErrorIs(t, err, string2eth.ErrNegative)
+
+	_, err = fmt.Sscan("ether", &first)
+	require.ErrorIs(t, err, string2eth.ErrEmptyValue)
+
+	_, err = fmt.Sscanf("1 ether", "%d", &first)
+	require.ErrorIs(t, err, string2eth.ErrInvalidFormat)
+}
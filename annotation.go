@@ -0,0 +1,60 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"strings"
+)
+
+// ParseAnnotatedValue turns a string in to a value, ignoring a single trailing
+// parenthesised annotation such as the fiat value added by block explorers,
+// e.g. "1.5 ETH ($3,902.11)" or "0.002 Ether ($5.20 USD)".  The annotation is
+// returned in the Annotation field of the value, without its parentheses.
+// The annotation is only ignored if it follows a value with a unit, so
+// "2 ($3)" returns ErrInvalidFormat.  The value is checked before the unit,
+// so "1.5 ($3)" returns ErrFractional as 1.5 Wei is not a whole number of Wei.
+// Input without an annotation is parsed as per ParseValue, so "(1.5 ETH)" is
+// a negative value and returns ErrNegative.
+// See StringToWei for details of the accepted input.
+func ParseAnnotatedValue(input string) (Value, error) {
+	text := strings.TrimSpace(normaliseWhitespace(input))
+	annotationStart := strings.LastIndexByte(text, '(')
+	if !strings.HasSuffix(text, ")") || annotationStart <= 0 {
+		return ParseValue(input)
+	}
+	leading := strings.TrimSpace(text[:annotationStart])
+	annotation := text[annotationStart+1 : len(text)-1]
+	if leading == "" || strings.ContainsAny(leading, "()") || strings.ContainsAny(annotation, "()") {
+		return ParseValue(input)
+	}
+
+	wei, unit, err := stringToWei(leading, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return Value{}, err
+	}
+	if unit == "" {
+		return Value{}, wrapError(ErrInvalidFormat, "annotation must follow a value with a unit")
+	}
+
+	// This will never fail because the unit has already been parsed.
+	unitPos, _ := unitToMetricPos(unit)
+
+	return Value{
+		Wei:        wei,
+		Unit:       metricUnits[unitPos],
+		Number:     numberText(leading, unit),
+		Annotation: strings.TrimSpace(annotation),
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseAnnotatedValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wei        *big.Int
+		unit       string
+		annotation string
+		err        error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:  "NoAnnotation",
+			input: "1.5 ETH",
+			wei:   big.NewInt(1500000000000000000),
+			unit:  "Ether",
+		},
+		{
+			name:       "Dollars",
+			input:      "1.5 ETH ($3,902.11)",
+			wei:        big.NewInt(1500000000000000000),
+			unit:       "Ether",
+			annotation: "$3,902.11",
+		},
+		{
+			name:       "DollarsUSD",
+			input:      "0.002 Ether ($5.20 USD)",
+			wei:        big.NewInt(2000000000000000),
+			unit:       "Ether",
+			annotation: "$5.20 USD",
+		},
+		{
+			name:       "NoSpace",
+			input:      "21 gwei($0.01)",
+			wei:        big.NewInt(21000000000),
+			unit:       "GWei",
+			annotation: "$0.01",
+		},
+		{
+			name:       "Padded",
+			input:      "  1,000 ETH ( €2,500,000 )  ",
+			wei:        _bigInt("1000000000000000000000"),
+			unit:       "Ether",
+			annotation: "€2,500,000",
+		},
+		{
+			name:  "Parenthesised",
+			input: "(1.5 ETH)",
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:  "NoUnit",
+			input: "2 ($3)",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "NoUnitDecimal",
+			input: "1.5 ($3)",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "BadLeading",
+			input: "1.5 foo ($3)",
+			err:   string2eth.ErrParseFailure,
+		},
+		{
+			name:  "Fractional",
+			input: "1.5 wei ($0.00)",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "MultipleAnnotations",
+			input: "1.5 ETH ($3,902.11) (£3,000)",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "NestedAnnotation",
+			input: "1.5 ETH ($3,902.11 (approx))",
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := string2eth.ParseAnnotatedValue(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wei, value.Wei)
+				require.Equal(t, test.unit, value.Unit)
+				require.Equal(t, test.annotation, value.Annotation)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// StringsToWei turns a slice of strings in to numbers of Wei.
+// The results and errors are in the same order as the inputs: for each input
+// either the result is set and the error is nil, or the result is nil and the
+// error is set.  Callers can decide whether to fail on the first error or
+// process the successful results.
+// See StringToWei for details.
+func StringsToWei(inputs []string) ([]*big.Int, []error) {
+	results := make([]*big.Int, len(inputs))
+	errs := make([]error, len(inputs))
+	for i, input := range inputs {
+		results[i], _, errs[i] = stringToWei(input, newParseOptions(DefaultUnitResolver))
+	}
+
+	return results, errs
+}
+
+// BatchItemError is the error for a single input in a batch.
+type BatchItemError struct {
+	// Index is the position of the input in the batch.
+	Index int
+	// Input is the input as supplied.
+	Input string
+	// Err is the error from parsing the input.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *BatchItemError) Error() string {
+	return "item " + strconv.Itoa(e.Index) + " (" + strconv.Quote(e.Input) + "): " + e.Err.Error()
+}
+
+// Unwrap returns the error from parsing the input.
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by StringsToWeiBatch when one or more inputs fail to
+// parse.  errors.Is(err, ErrParseFailure) is always true, and the underlying
+// errors are available with errors.Is, e.g. errors.Is(err, ErrFractional) is
+// true if any input was fractional.
+type BatchError struct {
+	// Items are the errors for each failed input, in order of their
+	// position in the batch.
+	Items []*BatchItemError
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	items := make([]string, len(e.Items))
+	for i, item := range e.Items {
+		items[i] = item.Error()
+	}
+
+	return ErrParseFailure.Error() + ": " + strings.Join(items, "; ")
+}
+
+// Unwrap returns ErrParseFailure followed by the errors for each failed input.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Items)+1)
+	errs = append(errs, ErrParseFailure)
+	for _, item := range e.Items {
+		errs = append(errs, item)
+	}
+
+	return errs
+}
+
+// batchOptions are the options for StringsToWeiBatch.
+type batchOptions struct {
+	stopOnError bool
+}
+
+// BatchOption is an option for StringsToWeiBatch.
+type BatchOption func(*batchOptions)
+
+// WithStopOnError stops parsing at the first input that fails, for
+// pipelines that fail fast.  Results for the inputs after the failure are
+// nil, and the error contains only the failure.
+func WithStopOnError() BatchOption {
+	return func(o *batchOptions) {
+		o.stopOnError = true
+	}
+}
+
+// StringsToWeiBatch turns a slice of strings in to numbers of Wei.
+// The results are in the same order as the inputs, with nil for any input
+// that failed to parse.  If any input fails to parse this returns a
+// *BatchError providing the position, input and error for each failure,
+// along with the results for the inputs that parsed.
+// See StringToWei for details.
+func StringsToWeiBatch(inputs []string, opts ...BatchOption) ([]*big.Int, error) {
+	options := &batchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	results := make([]*big.Int, len(inputs))
+	var batchErr *BatchError
+	for i, input := range inputs {
+		result, _, err := stringToWei(input, newParseOptions(DefaultUnitResolver))
+		if err != nil {
+			if batchErr == nil {
+				batchErr = &BatchError{}
+			}
+			batchErr.Items = append(batchErr.Items, &BatchItemError{
+				Index: i,
+				Input: input,
+				Err:   err,
+			})
+			if options.stopOnError {
+				break
+			}
+
+			continue
+		}
+		results[i] = result
+	}
+
+	if batchErr != nil {
+		return results, batchErr
+	}
+
+	return results, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringsToWei(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  []string
+		results []*big.Int
+		errs    []error
+	}{
+		{
+			name:    "Nil",
+			inputs:  nil,
+			results: []*big.Int{},
+			errs:    []error{},
+		},
+		{
+			name:    "Valid",
+			inputs:  []string{"1 wei", "21 gwei", "1.5 ether"},
+			results: []*big.Int{big.NewInt(1), big.NewInt(21000000000), big.NewInt(1500000000000000000)},
+			errs:    []error{nil, nil, nil},
+		},
+		{
+			name:    "Mixed",
+			inputs:  []string{"", "21 gwei", "1 foo", "1.5 wei", "-1 ether", "0x10"},
+			results: []*big.Int{nil, big.NewInt(21000000000), nil, nil, nil, big.NewInt(16)},
+			errs: []error{
+				string2eth.ErrEmptyValue,
+				nil,
+				string2eth.ErrParseFailure,
+				string2eth.ErrFractional,
+				string2eth.ErrNegative,
+				nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, errs := string2eth.StringsToWei(test.inputs)
+			require.Equal(t, test.results, results)
+			require.Len(t, errs, len(test.errs))
+			for i := range test.errs {
+				if test.errs[i] == nil {
+					require.NoError(t, errs[i])
+				} else {
+					require.ErrorIs(t, errs[i], test.errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStringsToWeiBatch(t *testing.T) {
+	inputs := []string{"1 wei", "", "21 gwei", "1.5 wei", "0x10"}
+
+	results, err := string2eth.StringsToWeiBatch(inputs)
+	require.Equal(t, []*big.Int{big.NewInt(1), nil, big.NewInt(21000000000), nil, big.NewInt(16)}, results)
+	require.EqualError(t, err, `failed to parse: item 1 (""): failed to parse empty value; `+
+		`item 3 ("1.5 wei"): value resulted in fractional number of Wei`)
+	require.ErrorIs(t, err, string2eth.ErrEmptyValue)
+	require.ErrorIs(t, err, string2eth.ErrFractional)
+	require.NotErrorIs(t, err, string2eth.ErrNegative)
+
+	var batchErr *string2eth.BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Len(t, batchErr.Items, 2)
+	require.Equal(t, 1, batchErr.Items[0].Index)
+	require.Equal(t, "", batchErr.Items[0].Input)
+	require.ErrorIs(t, batchErr.Items[0].Err, string2eth.ErrEmptyValue)
+	require.Equal(t, 3, batchErr.Items[1].Index)
+	require.Equal(t, "1.5 wei", batchErr.Items[1].Input)
+	require.ErrorIs(t, batchErr.Items[1].Err, string2eth.ErrFractional)
+}
+
+func TestStringsToWeiBatchParseFailure(t *testing.T) {
+	_, err := string2eth.StringsToWeiBatch([]string{"1.5 wei", "-1 wei"})
+	require.ErrorIs(t, err, string2eth.ErrParseFailure)
+	require.ErrorIs(t, err, string2eth.ErrFractional)
+	require.ErrorIs(t, err, string2eth.ErrNegative)
+}
+
+func TestStringsToWeiBatchStopOnError(t *testing.T) {
+	inputs := []string{"1 wei", "", "21 gwei", "1.5 wei"}
+
+	results, err := string2eth.StringsToWeiBatch(inputs, string2eth.WithStopOnError())
+	require.Equal(t, []*big.Int{big.NewInt(1), nil, nil, nil}, results)
+	require.EqualError(t, err, `failed to parse: item 1 (""): failed to parse empty value`)
+
+	var batchErr *string2eth.BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Len(t, batchErr.Items, 1)
+}
+
+func TestStringsToWeiBatchValid(t *testing.T) {
+	results, err := string2eth.StringsToWeiBatch([]string{"1 wei", "21 gwei"})
+	require.NoError(t, err)
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(21000000000)}, results)
+
+	results, err = string2eth.StringsToWeiBatch(nil, string2eth.WithStopOnError())
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
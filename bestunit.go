@@ -0,0 +1,49 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// BestUnit returns the unit that WeiToString would use for a value, along
+// with the multiplier for the unit, e.g. "GWei" and 10^9 for 1234567890 Wei.
+// This allows the unit to be used without formatting the value, for example
+// to align columns or label axes.
+// Zero and nil values return "Wei".  The sign of the value is ignored.
+// If the value is too large for any unit, for which WeiToString returns
+// "overflow", the unit is empty and the multiplier nil.
+func BestUnit(input *big.Int, standard bool) (string, *big.Int) {
+	unitPos := bestUnitPos(input, standard)
+	if unitPos >= len(metricUnits) {
+		return "", nil
+	}
+
+	return metricUnits[unitPos], new(big.Int).Set(metricMultipliers[unitPos])
+}
+
+// bestUnitPos returns the position in metricUnits of the unit that
+// WeiToString would use for a value.  This can be beyond the end of
+// metricUnits if the value is too large.
+func bestUnitPos(input *big.Int, standard bool) int {
+	if input == nil || input.Sign() == 0 {
+		return 0
+	}
+
+	value, unitPos := weiToStringStep1(new(big.Int).Abs(input))
+	_, _, desiredUnitPos, _ := weiToStringStep2(value, unitPos, standard)
+
+	return desiredUnitPos
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestBestUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      *big.Int
+		standard   bool
+		unit       string
+		multiplier string
+	}{
+		{name: "Nil", input: nil, unit: "Wei", multiplier: "1"},
+		{name: "Zero", input: big.NewInt(0), unit: "Wei", multiplier: "1"},
+		{name: "Wei", input: big.NewInt(123), unit: "Wei", multiplier: "1"},
+		{name: "KWei", input: big.NewInt(1234), unit: "KWei", multiplier: "1000"},
+		{name: "MWei", input: big.NewInt(1234567), unit: "MWei", multiplier: "1000000"},
+		{name: "GWei", input: big.NewInt(1234567890), unit: "GWei", multiplier: "1000000000"},
+		{name: "Microether", input: _bigInt("1234567890000"), unit: "Microether", multiplier: "1000000000000"},
+		{name: "Milliether", input: _bigInt("1234567890000000"), unit: "Milliether", multiplier: "1000000000000000"},
+		{name: "Ether", input: _bigInt("1000000000000000000"), unit: "Ether", multiplier: "1000000000000000000"},
+		{name: "Kiloether", input: _bigInt("1234000000000000000000"), unit: "Kiloether", multiplier: "1000000000000000000000"},
+		{name: "Megaether", input: _bigInt("1234000000000000000000000"), unit: "Megaether", multiplier: "1000000000000000000000000"},
+		{name: "Gigaether", input: _bigInt("1234000000000000000000000000"), unit: "Gigaether", multiplier: "1000000000000000000000000000"},
+		{name: "Teraether", input: _bigInt("1234000000000000000000000000000"), unit: "Teraether", multiplier: "1000000000000000000000000000000"},
+		{name: "Negative", input: big.NewInt(-1234567890), unit: "GWei", multiplier: "1000000000"},
+		{name: "Petaether", input: _bigInt("1234" + strings.Repeat("0", 30)), unit: "Petaether", multiplier: "1" + strings.Repeat("0", 33)},
+		{name: "Exaether", input: _bigInt("1234" + strings.Repeat("0", 33)), unit: "Exaether", multiplier: "1" + strings.Repeat("0", 36)},
+		{name: "Zettaether", input: _bigInt("1234" + strings.Repeat("0", 36)), unit: "Zettaether", multiplier: "1" + strings.Repeat("0", 39)},
+		{name: "Yottaether", input: _bigInt("1234" + strings.Repeat("0", 39)), unit: "Yottaether", multiplier: "1" + strings.Repeat("0", 42)},
+		{name: "Overflow", input: _bigInt("1" + strings.Repeat("0", 45))},
+		{name: "StandardWei", input: big.NewInt(123), standard: true, unit: "Wei", multiplier: "1"},
+		{name: "StandardKWei", input: big.NewInt(1234), standard: true, unit: "KWei", multiplier: "1000"},
+		{name: "StandardMWei", input: big.NewInt(1234567), standard: true, unit: "MWei", multiplier: "1000000"},
+		{name: "StandardGWei", input: big.NewInt(1234567890), standard: true, unit: "GWei", multiplier: "1000000000"},
+		{name: "StandardMicroether", input: _bigInt("1234567890000"), standard: true, unit: "GWei", multiplier: "1000000000"},
+		{name: "StandardMilliether", input: _bigInt("1234567890000000"), standard: true, unit: "Ether", multiplier: "1000000000000000000"},
+		{name: "StandardEther", input: _bigInt("1000000000000000000"), standard: true, unit: "Ether", multiplier: "1000000000000000000"},
+		{name: "StandardKiloether", input: _bigInt("1234000000000000000000"), standard: true, unit: "Ether", multiplier: "1000000000000000000"},
+		{name: "StandardTeraether", input: _bigInt("1234000000000000000000000000000"), standard: true, unit: "Ether", multiplier: "1000000000000000000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			unit, multiplier := string2eth.BestUnit(test.input, test.standard)
+			require.Equal(t, test.unit, unit)
+			if test.multiplier == "" {
+				require.Nil(t, multiplier)
+			} else {
+				require.Equal(t, test.multiplier, multiplier.Text(10))
+			}
+
+			// The unit must match that chosen by WeiToString.
+			output := string2eth.WeiToString(test.input, test.standard)
+			switch {
+			case test.unit == "":
+				require.Equal(t, "overflow", output)
+			case output != "0":
+				require.True(t, strings.HasSuffix(output, " "+test.unit), output)
+			}
+		})
+	}
+}
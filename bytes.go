@@ -0,0 +1,156 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import "math/big"
+
+// ParseWeiBytes turns a byte slice in to number of Wei.
+// The result is identical to that of StringToWei for the same input, however
+// simple values, such as "21 gwei" or "1.5ether", are parsed without
+// converting the input to a string or allocating intermediate strings.  This
+// is intended for parsing large numbers of values from buffers.
+// See StringToWei for details.
+func ParseWeiBytes(input []byte) (*big.Int, error) {
+	if result, ok := parseSimpleBytes(input); ok {
+		return result, nil
+	}
+
+	return StringToWei(string(input))
+}
+
+// maxSimpleDigits is the maximum number of digits in a simple value parsed
+// from bytes, which ensures that the number fits in a uint64.
+const maxSimpleDigits = 19
+
+// maxSimpleUnitLen is the maximum length of the unit in a simple value, which
+// allows it to be lower-cased in a fixed-size buffer.
+const maxSimpleUnitLen = 16
+
+// smallPowersOfTen are the powers of ten below one thousand.
+var smallPowersOfTen = []*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(100)}
+
+// simpleValue is the layout of a simple value.
+type simpleValue struct {
+	// numberEnd is the end of the number in the input.
+	numberEnd int
+	// digits is the number of digits in the number.
+	digits int
+	// decimals is the number of digits after the decimal point, excluding
+	// trailing zeros.
+	decimals int
+	// trailingZeros is the number of trailing zeros after the decimal point.
+	trailingZeros int
+	// unitPos is the position of the unit in metricUnits.
+	unitPos int
+}
+
+// scanSimple scans a simple value: digits with an optional decimal point and
+// further digits, optionally followed by a single space, and an optional unit
+// of ASCII letters, resulting in a whole number of Wei.
+// The final return value is false if the input is not a simple value, in
+// which case it should be parsed by StringToWei to obtain the result or error.
+func scanSimple[T string | []byte](input T) (simpleValue, bool) {
+	value := simpleValue{}
+	if len(input) > DefaultMaxInputLength {
+		// Leave the slow path to report the error.
+		return value, false
+	}
+	if len(input) > 1 && input[0] == '0' && (input[1] == 'x' || input[1] == 'X') {
+		// Hexadecimal.
+		return value, false
+	}
+	pos := 0
+	for ; pos < len(input) && isDigit(input[pos]); pos++ {
+		value.digits++
+	}
+	if value.digits == 0 {
+		return value, false
+	}
+	if pos < len(input) && input[pos] == '.' {
+		pos++
+		for ; pos < len(input) && isDigit(input[pos]); pos++ {
+			value.digits++
+			value.decimals++
+			if input[pos] == '0' {
+				value.trailingZeros++
+			} else {
+				value.trailingZeros = 0
+			}
+		}
+		if value.decimals == 0 {
+			return value, false
+		}
+		value.decimals -= value.trailingZeros
+	}
+	value.numberEnd = pos
+	if pos < len(input) && input[pos] == ' ' {
+		pos++
+		if pos == len(input) {
+			return value, false
+		}
+	}
+	unit := input[pos:]
+	if len(unit) > maxSimpleUnitLen {
+		return value, false
+	}
+	var lowerUnit [maxSimpleUnitLen]byte
+	for i := 0; i < len(unit); i++ {
+		if !isLetter(unit[i]) {
+			return value, false
+		}
+		lowerUnit[i] = unit[i] | 0x20
+	}
+
+	// Conversions from bytes to strings for lookups do not allocate.
+	var exists bool
+	value.unitPos, exists = siSymbols[string(unit)]
+	if !exists {
+		value.unitPos, exists = unitNamePos(string(lowerUnit[:len(unit)]))
+		if !exists {
+			return value, false
+		}
+	}
+
+	// The number is a whole number of Wei if the decimal places do not exceed
+	// those of the unit.
+	return value, value.decimals <= value.unitPos*3
+}
+
+// parseSimpleBytes parses a simple value.
+// The final return value is false if the input is not a simple value, in
+// which case it should be parsed by StringToWei to obtain the result or error.
+func parseSimpleBytes(input []byte) (*big.Int, bool) {
+	value, ok := scanSimple(input)
+	if !ok || value.digits > maxSimpleDigits {
+		return nil, false
+	}
+
+	var number uint64
+	for _, c := range input[:value.numberEnd] {
+		if c != '.' {
+			number = number*10 + uint64(c-'0')
+		}
+	}
+	// Trailing zeros in the decimal part have no effect on the value.
+	for i := 0; i < value.trailingZeros; i++ {
+		number /= 10
+	}
+
+	shift := value.unitPos*3 - value.decimals
+	result := new(big.Int).SetUint64(number)
+	result.Mul(result, metricMultipliers[shift/3])
+
+	return result.Mul(result, smallPowersOfTen[shift%3]), true
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+// simpleBoundaryInputs are inputs around the boundaries of simple values.
+var simpleBoundaryInputs = []string{
+	"0",
+	"00",
+	"0.0",
+	"007 gwei",
+	"1.0 wei",
+	"1.10 wei",
+	"1.000000000 gwei",
+	"1.0000000001 gwei",
+	"1.5",
+	"1.5 ",
+	"1.5  ether",
+	"1.5 ETHER",
+	"1.5 mETH",
+	"1.5 METH",
+	"1.5 xyz",
+	"1.5 kilowei",
+	"0.001 kwei",
+	"0.0001 kwei",
+	"0.0001 kiloether",
+	"0.000000000000000000001 kiloether",
+	"0.0000000000000000000001 kiloether",
+	"0.123456789 gwei",
+	"9999999999999999999",
+	"18446744073709551615",
+	"99999999999999999999 ether",
+	"0.0000000000000000001 ether",
+	"1e5",
+	"1e5 wei",
+	"1eth",
+	"0x10",
+	"0Xa gwei",
+	"1 abcdefghijklmnopqrstuvwxyz",
+}
+
+// equivalenceInputs returns the inputs for tests that check equivalence with
+// StringToWei.
+func equivalenceInputs() []string {
+	inputs := make([]string, 0, len(stringToWeiTests)+len(simpleBoundaryInputs))
+	for _, test := range stringToWeiTests {
+		inputs = append(inputs, test.input)
+	}
+
+	return append(inputs, simpleBoundaryInputs...)
+}
+
+func TestParseWeiBytesMatchesStringToWei(t *testing.T) {
+	for _, input := range equivalenceInputs() {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			expected, expectedErr := string2eth.StringToWei(input)
+			result, err := string2eth.ParseWeiBytes([]byte(input))
+			if expectedErr != nil {
+				require.EqualError(t, err, expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, expected.Text(10), result.Text(10))
+			}
+		})
+	}
+}
+
+func BenchmarkParseWeiBytes(b *testing.B) {
+	input := []byte("21 Gwei")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := string2eth.ParseWeiBytes(input)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseWeiBytesDecimal(b *testing.B) {
+	input := []byte("1.5 ether")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := string2eth.ParseWeiBytes(input)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
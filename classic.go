@@ -0,0 +1,50 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// classicUnitNames are the classic names of the units used by
+// WeiToStringClassic, by position in metricUnits.
+var classicUnitNames = map[int]string{
+	0: "wei",
+	4: "szabo",
+	5: "finney",
+	6: "ether",
+}
+
+// WeiToStringClassic turns a number of Wei in to a string using the classic
+// unit names, e.g. 10^12 Wei is "1 szabo" and 10^15 Wei is "1 finney".
+// Values for which WeiToString in non-standard mode would use Microether or
+// Milliether use szabo or finney respectively; smaller values use wei and
+// larger values use ether.
+func WeiToStringClassic(input *big.Int) string {
+	return WeiToStringWith(input, UnitFormatterFunc(classicUnit))
+}
+
+// classicUnit selects the classic unit in which to display the value.
+func classicUnit(value *big.Int) (string, *big.Int) {
+	unitPos := bestUnitPos(value, false)
+	switch {
+	case unitPos < 4:
+		unitPos = 0
+	case unitPos > etherPos:
+		unitPos = etherPos
+	}
+
+	return classicUnitNames[unitPos], metricMultipliers[unitPos]
+}
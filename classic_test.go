@@ -0,0 +1,112 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestWeiToStringClassic(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+	}{
+		{
+			name:   "Nil",
+			result: "0",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			result: "0",
+		},
+		{
+			name:   "Wei",
+			input:  big.NewInt(1),
+			result: "1 wei",
+		},
+		{
+			name:   "GWei",
+			input:  _bigInt("1000000000"),
+			result: "1000000000 wei",
+		},
+		{
+			name:   "BelowSzabo",
+			input:  _bigInt("999999999999"),
+			result: "999999999999 wei",
+		},
+		{
+			name:   "Szabo",
+			input:  _bigInt("1000000000000"),
+			result: "1 szabo",
+		},
+		{
+			name:   "FractionalSzabo",
+			input:  _bigInt("1500000000000"),
+			result: "1.5 szabo",
+		},
+		{
+			name:   "BelowFinney",
+			input:  _bigInt("999999999999999"),
+			result: "999.999999999999 szabo",
+		},
+		{
+			name:   "Finney",
+			input:  _bigInt("1000000000000000"),
+			result: "1 finney",
+		},
+		{
+			name:   "FractionalFinney",
+			input:  _bigInt("1234500000000000"),
+			result: "1.2345 finney",
+		},
+		{
+			name:   "BelowEther",
+			input:  _bigInt("999999999999999999"),
+			result: "999.999999999999999 finney",
+		},
+		{
+			name:   "Ether",
+			input:  _bigInt("1000000000000000000"),
+			result: "1 ether",
+		},
+		{
+			name:   "FractionalEther",
+			input:  _bigInt("1500000000000000000"),
+			result: "1.5 ether",
+		},
+		{
+			name:   "LargeEther",
+			input:  _bigInt("1000000000000000000000000000000000000"),
+			result: "1000000000000000000 ether",
+		},
+		{
+			name:   "NegativeFinney",
+			input:  _bigInt("-2000000000000000"),
+			result: "-2 finney",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToStringClassic(test.input))
+		})
+	}
+}
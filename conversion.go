@@ -20,19 +20,29 @@ package string2eth
 
 import (
 	"errors"
-	"fmt"
+	"math"
 	"math/big"
-	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 var (
-	ErrEmptyValue    = errors.New("failed to parse empty value")
-	ErrInvalidFormat = errors.New("invalid format")
-	ErrNegative      = errors.New("value resulted in negative number of Wei")
-	ErrFractional    = errors.New("value resulted in fractional number of Wei")
-	ErrUnknownUnit   = errors.New("unknown unit")
-	ErrParseFailure  = errors.New("failed to parse")
+	ErrEmptyValue          = errors.New("failed to parse empty value")
+	ErrInvalidFormat       = errors.New("invalid format")
+	ErrNegative            = errors.New("value resulted in negative number of Wei")
+	ErrFractional          = errors.New("value resulted in fractional number of Wei")
+	ErrUnknownUnit         = errors.New("unknown unit")
+	ErrParseFailure        = errors.New("failed to parse")
+	ErrAmbiguousSeparator  = errors.New("ambiguous decimal separator")
+	ErrInvalidRange        = errors.New("range minimum is greater than maximum")
+	ErrImplausibleGasPrice = errors.New("implausible gas price")
+	ErrTooManyDecimals     = errors.New("too many decimal places")
+	ErrValueTooLong        = errors.New("value too long")
+	ErrExceedsMaximum      = errors.New("value exceeds maximum")
+	ErrPrecisionLoss       = errors.New("value would lose precision")
+	ErrOverflow            = errors.New("value overflows")
 )
 
 // StringToWei turns a string in to number of Wei.
@@ -40,55 +50,394 @@ var (
 // be a number followed by a unit, e.g. "10 ether".  Unit names are
 // case-insensitive, and can be either given names (e.g. "finney") or metric
 // names (e.g. "mlliether").
+// The number can also be a hexadecimal integer with a leading "0x", e.g.
+// "0x1bc16d674ec80000" or "0x10 gwei", or be in scientific notation, e.g.
+// "1.5e9 gwei".
+// The symbol "Ξ" may be used before or after the number in place of the ether
+// unit, e.g. "Ξ1.5".
+// The unit may instead come before the number, e.g. "ETH 1.5" or "GWEI 21",
+// in which case there must not also be a unit after the number.
+// A magnitude suffix of k, m or b (case-insensitive) may follow the number
+// directly if it is separated from a unit, e.g. "1.5k ETH" or "2.3m ETH".
+// The number can include a Unicode vulgar fraction, e.g. "1½ ether", or be a
+// rational, e.g. "1/4 ether".
+// The value can also be a sum of values in descending units, e.g.
+// "1 ether 500 finney".
+// Commas may be used as thousands separators in the integer part of the number,
+// e.g. "1,000,000 ether", as may underscores between digits, e.g.
+// "1_000_000 ether".  Underscores are not permitted in the fractional part.
+// Unicode decimal digits, e.g. full-width "１．５", are treated as their ASCII
+// equivalents.
+// A leading plus sign, and a single trailing full stop, comma or semicolon
+// after the unit, e.g. "+1.5 ether.", are ignored.
+// Any Unicode whitespace, e.g. tabs and non-breaking spaces, is ignored, as
+// are invisible characters such as zero-width spaces, soft hyphens, byte order
+// marks and bidirectional controls.  An input of only whitespace and invisible
+// characters returns ErrEmptyValue.
+// An input longer than DefaultMaxInputLength characters, excluding leading and
+// trailing whitespace, returns ErrValueTooLong.
 // Note that this function expects use of the period as the decimal separator.
 func StringToWei(input string) (*big.Int, error) {
-	if input == "" {
-		return nil, ErrEmptyValue
+	value, err := ParseValue(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.Wei, nil
+}
+
+// StringToWeiWith turns a string in to number of Wei, using the supplied
+// resolver to obtain the multiplier for the unit.
+// See StringToWei for details.
+func StringToWeiWith(input string, resolver UnitResolver) (*big.Int, error) {
+	if resolver == nil {
+		resolver = DefaultUnitResolver
+	}
+	result, _, err := stringToWei(input, newParseOptions(resolver))
+
+	return result, err
+}
+
+// StringToWeiWithDefaultUnit turns a string in to number of Wei, using the
+// default unit if the string does not contain a unit, e.g. "0.5" with default
+// unit "ether" is 0.5 Ether.
+// If the string contains a unit this behaves identically to StringToWei.
+func StringToWeiWithDefaultUnit(input string, defaultUnit string) (*big.Int, error) {
+	if _, err := multiplierFor(defaultUnit); err != nil {
+		return nil, err
+	}
+
+	resolver := UnitResolverFunc(func(unit string) (*big.Int, error) {
+		if unit == "" {
+			unit = defaultUnit
+		}
+
+		return multiplierFor(unit)
+	})
+	result, _, err := stringToWei(input, newParseOptions(resolver))
+
+	return result, err
+}
+
+// StringToWeiWithUnit turns a string in to number of Wei, also returning the
+// canonical name of the unit in which the value was supplied, e.g. "GWei" for
+// "21 gwei".  If no unit was supplied the unit is "Wei".
+// See StringToWei for details.
+func StringToWeiWithUnit(input string) (*big.Int, string, error) {
+	value, err := ParseValue(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return value.Wei, value.Unit, nil
+}
+
+// StringToWeiSigned turns a string in to number of Wei, permitting negative
+// values.
+// A leading minus sign negates any value accepted by StringToWei, e.g.
+// "-1.5 ether", "-1/4 ether", "-0x10" or "-1 ether 500 finney".  A value
+// wrapped in a single pair of parentheses, e.g. "(1.5 ether)", is also treated
+// as negative.
+// See StringToWei for details.
+func StringToWeiSigned(input string) (*big.Int, error) {
+	opts := newParseOptions(DefaultUnitResolver)
+	opts.signed = true
+	result, _, err := stringToSignedWei(input, opts)
+
+	return result, err
+}
+
+// StringToWeiRounded turns a string in to number of Wei, rounding any
+// fractional number of Wei as per the rounding mode rather than returning
+// ErrFractional, e.g. "2.8765432 megawei" is 2876543 Wei when rounded down and
+// 2876544 Wei when rounded up.
+// See StringToWei for details.
+func StringToWeiRounded(input string, mode RoundingMode) (*big.Int, error) {
+	opts := newParseOptions(DefaultUnitResolver)
+	opts.rounding = true
+	opts.mode = mode
+	result, _, err := stringToWei(input, opts)
+
+	return result, err
+}
+
+// parseOptions are the options that control the parsing of a string in to a
+// number of Wei.
+type parseOptions struct {
+	// resolver obtains the multiplier for a unit.
+	resolver UnitResolver
+	// rounding is true if a fractional number of Wei is rounded as per mode,
+	// rather than being an error.
+	rounding bool
+	mode     RoundingMode
+	// limitDecimals is true if the number may have no more than maxDecimals
+	// decimal places.
+	limitDecimals bool
+	maxDecimals   int
+	// maxLength is the maximum number of characters in the input, or 0 or
+	// less for no limit.
+	maxLength int
+	// signed is true if a leading minus sign negates the value that follows
+	// it, which may be in any form accepted for unsigned values.
+	signed bool
+}
+
+// newParseOptions returns the default parse options with the given resolver.
+func newParseOptions(resolver UnitResolver) *parseOptions {
+	return &parseOptions{
+		resolver:  resolver,
+		maxLength: DefaultMaxInputLength,
+	}
+}
+
+// stringToWei turns a string in to number of Wei, also returning the unit
+// as supplied in the string.
+func stringToWei(input string, opts *parseOptions) (*big.Int, string, error) {
+	result, units, err := stringToSignedWei(input, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Ensure we don't have a negative number.
+	if result.Sign() < 0 {
+		if _, isParenthesised := parenthesisedValue(input); isParenthesised {
+			return nil, "", wrapError(ErrNegative, "parentheses denote a negative value")
+		}
+
+		return nil, "", ErrNegative
+	}
+
+	return result, units, nil
+}
+
+// parenthesisedValue returns the value inside an input wrapped in a single
+// pair of parentheses, e.g. "(1.5 ether)".
+// The final return value is false if the input is not wrapped in parentheses.
+func parenthesisedValue(input string) (string, bool) {
+	input = strings.TrimSpace(input)
+	if len(input) < 2 || input[0] != '(' || input[len(input)-1] != ')' {
+		return "", false
+	}
+	inner := input[1 : len(input)-1]
+	if strings.ContainsAny(inner, "()") {
+		return "", false
+	}
+
+	return inner, true
+}
+
+// stringToSignedWei turns a string in to number of Wei, which may be negative,
+// also returning the unit as supplied in the string.
+func stringToSignedWei(input string, opts *parseOptions) (*big.Int, string, error) {
+	if err := checkInputLength(input, opts.maxLength); err != nil {
+		return nil, "", err
+	}
+
+	result, units, err := parseSignedWei(input, opts)
+	if err != nil {
+		// Parsing may recurse, so this overwrites the input of any inner call
+		// to leave the input as supplied by the caller.
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			parseErr.locate(input)
+		}
+
+		return nil, "", err
 	}
 
+	return result, units, nil
+}
+
+// parseSignedWei carries out the work of stringToSignedWei.
+func parseSignedWei(input string, opts *parseOptions) (*big.Int, string, error) {
+	input = normaliseWhitespace(input)
+	if strings.TrimSpace(input) == "" {
+		return nil, "", ErrEmptyValue
+	}
+	original := input
+
+	if inner, isParenthesised := parenthesisedValue(input); isParenthesised {
+		// The value inside the parentheses must itself be positive.
+		result, units, err := stringToWei(inner, opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.Neg(result), units, nil
+	}
+	if strings.ContainsAny(input, "()") {
+		return nil, "", wrapError(ErrInvalidFormat, "unbalanced or nested parentheses")
+	}
+
+	input = normaliseDigits(input)
+	input = normalisePunctuation(input)
+	if opts.signed && strings.HasPrefix(input, "-") {
+		// The value after the sign is parsed as an unsigned value, so that
+		// any form it accepts may be negated.
+		unsigned := *opts
+		unsigned.signed = false
+		result, units, err := stringToWei(input[1:], &unsigned)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return result.Neg(result), units, nil
+	}
+	input, err := moveUnitPrefix(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if strings.Contains(input, "/") {
+		return rationalStringToWei(input, opts)
+	}
+
+	input = expandMagnitude(input, opts.resolver)
+
 	// Remove unused runes that may be in an input string.
 	input = strings.ReplaceAll(input, " ", "")
-	input = strings.ReplaceAll(input, "_", "")
+	input, err = removeUnderscores(input)
+	if err != nil {
+		return nil, "", err
+	}
+	input = replaceEtherSymbol(input)
+	input = replaceMicroSign(input)
+	if result, units, isFraction, err := vulgarFractionToWei(input, opts); isFraction {
+		return result, units, err
+	}
+	input, err = removeGrouping(input)
+	if err != nil {
+		return nil, "", err
+	}
 
 	var result big.Int
+	if strings.HasPrefix(input, "0x") || strings.HasPrefix(input, "0X") {
+		units, err := hexStringToWei(input[2:], opts, &result)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return &result, units, nil
+	}
+
 	// Separate the number from the unit (if any)
-	re := regexp.MustCompile(`^(-?[0-9]*(?:\.[0-9]*)?)([A-Za-z]+)?$`)
-	subMatches := re.FindAllStringSubmatch(input, -1)
-	var units string
-	if len(subMatches) != 1 {
-		return nil, ErrInvalidFormat
-	}
-	units = subMatches[0][2]
-	if strings.Contains(subMatches[0][1], ".") {
-		err := decimalStringToWei(subMatches[0][1], units, &result)
+	amount, units, ok := splitAmount(input)
+	if !ok {
+		if pairs, isCompound := scanCompound(input); isCompound && len(pairs) > 1 {
+			return compoundStringToWei(pairs, opts)
+		}
+		if hasMultipleDecimalPoints(input) {
+			return nil, "", wrapError(ErrInvalidFormat, "multiple decimal points")
+		}
+		if err := checkUnitRunes(input); err != nil {
+			return nil, "", err
+		}
+		if isLetters(strings.TrimLeft(input, "-.")) {
+			// A sign, decimal point and/or unit without any digits.
+			return nil, "", wrapError(ErrInvalidFormat, "no number in "+strconv.Quote(original))
+		}
+
+		return nil, "", ErrInvalidFormat
+	}
+	if strings.ContainsAny(amount, "eE") {
+		amount, err = expandExponent(amount)
 		if err != nil {
-			return nil, err
+			return nil, "", err
+		}
+	}
+	if strings.Contains(amount, ".") {
+		err := decimalStringToWei(amount, units, opts, &result)
+		if err != nil {
+			return nil, "", err
 		}
 	} else {
-		err := integerStringToWei(subMatches[0][1], units, &result)
+		err := integerStringToWei(amount, units, opts.resolver, &result)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
-	// Ensure we don't have a negative number.
-	if result.Cmp(new(big.Int)) < 0 {
-		return nil, ErrNegative
-	}
-
-	return &result, nil
+	return &result, units, nil
 }
 
 // StringToGWei turns a string in to number of GWei.
 // See StringToWei for details.
-// Any part of the value below 1GWei in denomination is lost.
+// Any part of the value below 1GWei in denomination is lost; this is the same
+// as StringToGWeiRounded with RoundFloor.
 func StringToGWei(input string) (uint64, error) {
+	return StringToGWeiRounded(input, RoundFloor)
+}
+
+// StringToGWeiRounded turns a string in to number of GWei, rounding any part
+// of the value below 1GWei as per the rounding mode, e.g. "0.7 gwei" is 0 GWei
+// with RoundFloor and 1 GWei with RoundCeiling.
+// ErrOverflow is returned if the rounded value does not fit in a uint64.
+// See StringToWei for details.
+func StringToGWeiRounded(input string, mode RoundingMode) (uint64, error) {
 	wei, err := StringToWei(input)
 	if err != nil {
 		return 0, err
 	}
 
-	return wei.Div(wei, billion).Uint64(), nil
+	return gweiToUint64(divRound(wei, billion, mode))
+}
+
+// gweiToUint64 returns a number of GWei as a uint64, or ErrOverflow if it does
+// not fit.
+func gweiToUint64(gwei *big.Int) (uint64, error) {
+	if !gwei.IsUint64() {
+		return 0, wrapError(ErrOverflow, gwei.Text(10)+" GWei is above the maximum of "+strconv.FormatUint(math.MaxUint64, 10)+" GWei")
+	}
+
+	return gwei.Uint64(), nil
+}
+
+// StringToGWeiExact turns a string in to number of GWei.
+// See StringToWei for details.
+// Unlike StringToGWei, this returns ErrPrecisionLoss if the value is not a
+// whole number of GWei rather than losing the part of the value below 1GWei.
+// The error also matches ErrFractional.
+// ErrOverflow is returned if the value does not fit in a uint64.
+func StringToGWeiExact(input string) (uint64, error) {
+	wei, err := StringToWei(input)
+	if err != nil {
+		return 0, err
+	}
+
+	gwei, remainder := new(big.Int).QuoRem(wei, billion, new(big.Int))
+	if remainder.Sign() != 0 {
+		return 0, &ParseError{
+			Offset:   -1,
+			Guidance: remainder.Text(10) + " Wei below 1 GWei would be discarded",
+			Err:      ErrPrecisionLoss,
+			Cause:    ErrFractional,
+		}
+	}
+
+	return gweiToUint64(gwei)
+}
+
+// ToWeiString turns a string in to an integer string of the number of Wei,
+// e.g. "1.5 ether" becomes "1500000000000000000".
+// See StringToWei for details.
+func ToWeiString(input string) (string, error) {
+	wei, err := StringToWei(input)
+	if err != nil {
+		return "", err
+	}
+
+	return wei.Text(10), nil
+}
+
+// ExceedsGWei returns true if the number of Wei is greater than the given
+// number of GWei.
+func ExceedsGWei(weiValue *big.Int, gweiLimit uint64) bool {
+	if weiValue == nil {
+		return false
+	}
+	limit := new(big.Int).Mul(new(big.Int).SetUint64(gweiLimit), billion)
+
+	return weiValue.Cmp(limit) > 0
 }
 
 // Used in WeiToString.
@@ -100,10 +449,15 @@ var (
 // Used in GWeiToString.
 var billion = big.NewInt(1000000000)
 
+// GWeiToWei turns a number of GWei in to a number of Wei.
+func GWeiToWei(input uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(input), billion)
+}
+
 // GWeiToString turns a number of GWei in to a string.
 // See WeiToString for details.
 func GWeiToString(input uint64, standard bool) string {
-	return WeiToString(new(big.Int).Mul(new(big.Int).SetUint64(input), billion), standard)
+	return WeiToString(GWeiToWei(input), standard)
 }
 
 // WeiToGWeiString turns a number of wei in to a Gwei string.
@@ -117,44 +471,333 @@ func WeiToGWeiString(input *big.Int) string {
 
 	// Return our value.
 	if decValue.Cmp(zero) == 0 {
-		return fmt.Sprintf("%s GWei", intValue)
+		return intValue.String() + " GWei"
+	}
+	decStr := decValue.Text(10)
+	decStr = strings.TrimRight(strings.Repeat("0", 9-len(decStr))+decStr, "0")
+
+	return intValue.String() + "." + decStr + " GWei"
+}
+
+// WeiToGWeiStringRounded turns a number of wei in to a Gwei string, rounded
+// half-up to at most the given number of decimal places, e.g. 999000050000
+// Wei to 4 decimal places is "999.0001 GWei".
+// Trailing zeros are removed.
+func WeiToGWeiStringRounded(input *big.Int, decimals int) string {
+	if input == nil {
+		return "0"
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	if decimals >= 9 {
+		return WeiToGWeiString(input)
+	}
+
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(9-decimals)), nil)
+	rounded := divRound(input, divisor, RoundHalfUp)
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	intValue, decValue := new(big.Int).QuoRem(new(big.Int).Abs(rounded), scale, new(big.Int))
+	outputValue := intValue.Text(10)
+	if rounded.Sign() < 0 {
+		outputValue = "-" + outputValue
+	}
+	if decValue.Sign() != 0 {
+		decStr := decValue.Text(10)
+		decStr = strings.TrimRight(strings.Repeat("0", decimals-len(decStr))+decStr, "0")
+		outputValue += "." + decStr
 	}
-	decStr := strings.TrimRight(fmt.Sprintf("%09d", decValue.Int64()), "0")
 
-	return fmt.Sprintf("%s.%s GWei", intValue, decStr)
+	return outputValue + " GWei"
 }
 
 // WeiToString turns a number of Wei in to a string.
 // If the 'standard' argument is true then this will display the value
 // in either (KMG)Wei or Ether only.
+// Negative values are displayed with a leading "-", e.g. "-1.5 Ether".
+// See WeiToStringOptions for further formatting options.
 func WeiToString(input *big.Int, standard bool) string {
+	if standard {
+		return WeiToStringOptions(input, WithStandardUnits())
+	}
+
+	return WeiToStringOptions(input)
+}
+
+// WeiToStringGrouped turns a number of Wei in to a string, as per WeiToString,
+// with the separator inserted between each group of three digits in the
+// integer part of the value, e.g. "1,000 Ether".
+// The fractional part of the value is not grouped.
+func WeiToStringGrouped(input *big.Int, standard bool, groupSep rune) string {
+	return groupOutput(WeiToString(input, standard), groupSep)
+}
+
+// WeiToStringMax turns a number of Wei in to a string, as per WeiToString in
+// non-standard mode, but never selecting a unit larger than the given unit.
+// This allows large values to be displayed rather than "overflow", e.g.
+// 10^33 Wei with maximum unit "ether" is "1000000000000000 Ether".
+// If the unit is not known this returns "unknown unit".
+func WeiToStringMax(input *big.Int, maxUnit string) string {
+	maxUnitPos, err := unitToMetricPos(maxUnit)
+	if err != nil {
+		return ErrUnknownUnit.Error()
+	}
 	if input == nil {
 		return "0"
 	}
 
-	// Take a copy of the input so that we can mutate it.
-	value := new(big.Int).Set(input)
+	// Take a copy of the input so that we can mutate it.  The steps work
+	// with the absolute value, with the sign added to the output.
+	value := new(big.Int).Abs(input)
+	sign := ""
+	if input.Sign() < 0 {
+		sign = "-"
+	}
 
 	// Short circuit on 0.
 	if value.Cmp(zero) == 0 {
 		return "0"
 	}
 
-	// Step 1: work out simple units, keeping value as a whole number.
 	value, unitPos := weiToStringStep1(value)
+	outputValue, unitPos, desiredUnitPos, decimalPlace := weiToStringStep2(value, unitPos, false)
+	if desiredUnitPos > maxUnitPos {
+		desiredUnitPos = maxUnitPos
+	}
+	outputValue, unitPos = weiToStringStep3(outputValue, unitPos, desiredUnitPos, decimalPlace)
+
+	return sign + outputValue + " " + metricUnits[unitPos]
+}
+
+// WeiToStringUnit turns a number of Wei in to a string in the given unit,
+// e.g. 10^18 Wei with unit "gwei" is "1000000000 GWei".
+// The unit can be any name accepted by UnitToMultiplier, and the output uses
+// the metric name of the unit.
+func WeiToStringUnit(input *big.Int, unit string) (string, error) {
+	unitPos, err := unitToMetricPos(unit)
+	if err != nil {
+		return "", err
+	}
 
-	// Step 2: move value to a fraction if sensible.
-	outputValue, unitPos, desiredUnitPos, decimalPlace := weiToStringStep2(value, unitPos, standard)
+	return weiToUnitString(input, unitPos), nil
+}
 
-	// Step 3: generate output.
-	outputValue, unitPos = weiToStringStep3(outputValue, unitPos, desiredUnitPos, decimalPlace)
+// WeiToStringMinimalExact turns a number of Wei in to the shortest string,
+// across all metric units, that represents the value exactly.  Where strings
+// are of equal length the larger unit is used.
+// The value is never rounded, so the output parses back to the same number of
+// Wei.
+func WeiToStringMinimalExact(input *big.Int) string {
+	if input == nil || input.Sign() == 0 {
+		return "0"
+	}
 
-	if unitPos >= len(metricUnits) {
-		return "overflow"
+	result := ""
+	for unitPos := len(metricUnits) - 1; unitPos >= 0; unitPos-- {
+		candidate := weiToUnitString(input, unitPos)
+		if result == "" || len(candidate) < len(result) {
+			result = candidate
+		}
 	}
 
-	// Return our value.
-	return fmt.Sprintf("%s %s", outputValue, metricUnits[unitPos])
+	return result
+}
+
+// WeiToStringFixed turns a number of Wei in to a string in the given unit with
+// exactly the given number of decimal places, e.g. "1.0000 Ether".
+// The value is rounded to the number of decimal places using the rounding
+// mode, and trailing zeros are retained.
+// If the unit is not known this returns "unknown unit".
+func WeiToStringFixed(input *big.Int, unit string, decimals int, mode RoundingMode) string {
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return ErrUnknownUnit.Error()
+	}
+	name, err := MultiplierToUnit(multiplier)
+	if err != nil {
+		name = unit
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	value := new(big.Int)
+	if input != nil {
+		value.Set(input)
+	}
+	value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	value = divRound(value, multiplier, mode)
+
+	digits := new(big.Int).Abs(value).Text(10)
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals+1-len(digits)) + digits
+	}
+	outputValue := digits
+	if decimals > 0 {
+		outputValue = digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+	}
+	if value.Sign() < 0 {
+		outputValue = "-" + outputValue
+	}
+
+	return outputValue + " " + name
+}
+
+// compactSuffixes are the magnitude suffixes used by WeiToStringCompact.
+var compactSuffixes = []string{"", "k", "M", "B", "T"}
+
+// WeiToStringCompact turns a number of Wei in to a compact string of Ether
+// with a magnitude suffix, as commonly used in finance, e.g. "1.5k ETH" or
+// "2.3M ETH".
+// The suffixes k, M, B and T stand for thousand, million, billion and
+// trillion respectively.  The value is rounded half up to at most two decimal
+// places, so small values may display as "0 ETH".
+func WeiToStringCompact(input *big.Int) string {
+	value := new(big.Int)
+	if input != nil {
+		value.Set(input)
+	}
+
+	// Find the largest suffix that keeps the value at or above 1.
+	// Each suffix is a multiple of 1,000 Ether, matching the metric units.
+	abs := new(big.Int).Abs(value)
+	suffixPos := 0
+	for suffixPos < len(compactSuffixes)-1 && abs.Cmp(metricMultipliers[etherPos+suffixPos+1]) >= 0 {
+		suffixPos++
+	}
+
+	hundredths := compactHundredths(value, suffixPos)
+	// Rounding can take the value to the next suffix, e.g. 999.999k to 1000k.
+	if suffixPos < len(compactSuffixes)-1 && new(big.Int).Abs(hundredths).Cmp(big.NewInt(100000)) >= 0 {
+		suffixPos++
+		hundredths = compactHundredths(value, suffixPos)
+	}
+
+	intValue, decValue := new(big.Int).QuoRem(new(big.Int).Abs(hundredths), big.NewInt(100), new(big.Int))
+	outputValue := intValue.Text(10)
+	if decValue.Sign() != 0 {
+		decStr := decValue.Text(10)
+		outputValue += "." + strings.TrimRight(strings.Repeat("0", 2-len(decStr))+decStr, "0")
+	}
+	if hundredths.Sign() < 0 {
+		outputValue = "-" + outputValue
+	}
+
+	return outputValue + compactSuffixes[suffixPos] + " ETH"
+}
+
+// compactHundredths returns the number of hundredths of Ether in the value
+// for the suffix at the given position.
+func compactHundredths(value *big.Int, suffixPos int) *big.Int {
+	// One hundredth of an Ether is 10^16 Wei.
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(16+suffixPos*3)), nil)
+
+	return divRound(value, denominator, RoundHalfUp)
+}
+
+// WeiToStringEngineering turns a number of Wei in to a string in engineering
+// notation, e.g. "1.5e18 wei" or "15e9 wei".
+// The exponent is the largest multiple of 3 that leaves a mantissa with 1 to 3
+// integer digits, so exponents align with the metric units.  The mantissa is
+// exact, with trailing zeros after the decimal point removed.  The exponent is
+// omitted for values below 1000 Wei, e.g. "999 wei".
+func WeiToStringEngineering(input *big.Int) string {
+	if input == nil || input.Sign() == 0 {
+		return "0 wei"
+	}
+
+	digits := new(big.Int).Abs(input).Text(10)
+	exponent := ((len(digits) - 1) / 3) * 3
+	intDigits := len(digits) - exponent
+
+	mantissa := digits[:intDigits]
+	if decDigits := strings.TrimRight(digits[intDigits:], "0"); decDigits != "" {
+		mantissa += "." + decDigits
+	}
+	if input.Sign() < 0 {
+		mantissa = "-" + mantissa
+	}
+	if exponent == 0 {
+		return mantissa + " wei"
+	}
+
+	return mantissa + "e" + strconv.Itoa(exponent) + " wei"
+}
+
+// WeiToStringScientific turns a number of Wei in to a string of Ether in
+// scientific notation, e.g. "1.2345e6 Ether" or "1e-9 Ether", which has a
+// bounded width regardless of the magnitude of the value.
+// The mantissa is rounded half up to at most the given number of significant
+// figures, with trailing zeros removed.  The exponent is omitted when it is 0,
+// e.g. "1.5 Ether".
+func WeiToStringScientific(input *big.Int, sigFigs int) string {
+	if input == nil || input.Sign() == 0 {
+		return "0 Ether"
+	}
+	if sigFigs < 1 {
+		sigFigs = 1
+	}
+
+	value := new(big.Int).Abs(input)
+	digits := value.Text(10)
+	// One Ether is 10^18 Wei.
+	exponent := len(digits) - 1 - 18
+	if len(digits) > sigFigs {
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(digits)-sigFigs)), nil)
+		digits = divRound(value, divisor, RoundHalfUp).Text(10)
+		// Rounding can add a digit, e.g. 9.99 to 10.0.
+		if len(digits) > sigFigs {
+			exponent++
+			digits = digits[:sigFigs]
+		}
+	}
+
+	mantissa := digits[:1]
+	if decDigits := strings.TrimRight(digits[1:], "0"); decDigits != "" {
+		mantissa += "." + decDigits
+	}
+	if input.Sign() < 0 {
+		mantissa = "-" + mantissa
+	}
+	if exponent == 0 {
+		return mantissa + " Ether"
+	}
+
+	return mantissa + "e" + strconv.Itoa(exponent) + " Ether"
+}
+
+// weiToUnitString turns a number of Wei in to a string in the metric unit at
+// the given position, without moving to a different unit.
+func weiToUnitString(input *big.Int, unitPos int) string {
+	value := new(big.Int)
+	if input != nil {
+		value.Abs(input)
+	}
+
+	intValue, decValue := new(big.Int).QuoRem(value, metricMultipliers[unitPos], new(big.Int))
+
+	outputValue := intValue.Text(10)
+	if input != nil && input.Sign() < 0 {
+		outputValue = "-" + outputValue
+	}
+	if decValue.Sign() != 0 {
+		decStr := decValue.Text(10)
+		decStr = strings.Repeat("0", unitPos*3-len(decStr)) + decStr
+		outputValue += "." + strings.TrimRight(decStr, "0")
+	}
+
+	return outputValue + " " + metricUnits[unitPos]
+}
+
+// unitToMetricPos returns the position in metricUnits of the given unit.
+func unitToMetricPos(unit string) (int, error) {
+	unitPos, exists := lookupUnitPos(unit)
+	if !exists {
+		return 0, newUnknownUnitError(unit)
+	}
+
+	return unitPos, nil
 }
 
 // weiToStringStep1 steps the value down by thousands to obtain a smaller value
@@ -219,23 +862,30 @@ func weiToStringStep3(outputValue string, unitPos int, desiredUnitPos int, decim
 
 	// Trim trailing zeros if this is a decimal.
 	if strings.Contains(outputValue, ".") {
-		outputValue = strings.TrimRight(outputValue, "0")
+		outputValue = strings.TrimSuffix(strings.TrimRight(outputValue, "0"), ".")
 	}
 
 	return outputValue, unitPos
 }
 
-func decimalStringToWei(amount string, unit string, result *big.Int) error {
+func decimalStringToWei(amount string, unit string, opts *parseOptions, result *big.Int) error {
 	// Because floating point maths is not accurate we need to break potentially
 	// large decimal fractions in to two separate pieces: the integer part and the
 	// decimal part.
 	parts := strings.Split(amount, ".")
 
+	// The sign applies to both parts of the number, so is handled separately.
+	negative := strings.HasPrefix(parts[0], "-")
+	parts[0] = strings.TrimPrefix(parts[0], "-")
+	if parts[0] == "" && parts[1] == "" {
+		return wrapError(ErrInvalidFormat, "no digits in "+amount)
+	}
+
 	// The value for the integer part of the number is easy.
 	if parts[0] != "" {
-		err := integerStringToWei(parts[0], unit, result)
+		err := integerStringToWei(parts[0], unit, opts.resolver, result)
 		if err != nil {
-			return fmt.Errorf("%w %s %s", ErrParseFailure, amount, unit)
+			return unitParseError(amount, unit, err)
 		}
 	}
 
@@ -244,50 +894,408 @@ func decimalStringToWei(amount string, unit string, result *big.Int) error {
 	// latter is unreliable.
 
 	// Obtain multiplier.
-	// This will never fail because it is already called above in integerStringToWei().
-	multiplier, _ := UnitToMultiplier(unit)
+	multiplier, err := opts.resolver.Multiplier(unit)
+	if err != nil {
+		return unitParseError(amount, unit, err)
+	}
 
 	// Trim trailing 0s.
 	trimmedDecimal := strings.TrimRight(parts[1], "0")
+	if err := checkDecimals(len(trimmedDecimal), opts); err != nil {
+		return err
+	}
 	if len(trimmedDecimal) == 0 {
 		// Nothing more to do.
+		if negative {
+			result.Neg(result)
+		}
+
 		return nil
 	}
 	var decVal big.Int
 	decVal.SetString(trimmedDecimal, 10)
 
-	// Divide multiplier by 10^len(trimmed decimal) to obtain sane value.
-	div := big.NewInt(10)
-	for i := 0; i < len(trimmedDecimal); i++ {
-		multiplier.Div(multiplier, div)
+	// Multiply by the multiplier and add to the integer result scaled up by
+	// 10^len(trimmed decimal), then divide by the same to obtain sane value.
+	// The whole value is divided so that a fractional number of Wei is rounded
+	// correctly, or rejected, depending on the options.
+	div := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(trimmedDecimal))), nil)
+	scaled := new(big.Int).Mul(result, div)
+	scaled.Add(scaled, new(big.Int).Mul(multiplier, &decVal))
+	value, err := divWei(scaled, div, opts)
+	if err != nil {
+		return err
+	}
+	result.Set(value)
+	if negative {
+		result.Neg(result)
+	}
+
+	return nil
+}
+
+// magnitudeExponents are the exponents for magnitude suffixes.
+var magnitudeExponents = map[byte]string{
+	'k': "e3",
+	'K': "e3",
+	'm': "e6",
+	'M': "e6",
+	'b': "e9",
+	'B': "e9",
+}
+
+// expandMagnitude replaces a magnitude suffix on the number, e.g. the "k" in
+// "1.5k ETH", with the equivalent exponent.
+// To avoid ambiguity with units such as "mwei" the suffix is only treated as a
+// magnitude if it directly follows the number and is followed by whitespace
+// and a unit known to the resolver, so "2.3m ETH" is 2.3 million Ether but
+// "2.3mETH" and "2.3 mETH" are 2.3 milliether.
+func expandMagnitude(input string, resolver UnitResolver) string {
+	trimmed := strings.TrimLeftFunc(input, unicode.IsSpace)
+	numberEnd := 0
+	digits := 0
+	for numberEnd < len(trimmed) && strings.IndexByte("-0123456789.,_", trimmed[numberEnd]) != -1 {
+		if isDigit(trimmed[numberEnd]) {
+			digits++
+		}
+		numberEnd++
+	}
+	if digits == 0 || numberEnd+1 >= len(trimmed) {
+		return input
+	}
+	exponent, exists := magnitudeExponents[trimmed[numberEnd]]
+	if !exists || !unicode.IsSpace(rune(trimmed[numberEnd+1])) {
+		return input
+	}
+	unit := strings.TrimSpace(trimmed[numberEnd+1:])
+	if unit == "" {
+		return input
+	}
+	if _, err := resolver.Multiplier(strings.ReplaceAll(unit, " ", "")); err != nil {
+		return input
+	}
+
+	return trimmed[:numberEnd] + exponent + unit
+}
+
+// etherSymbols are symbols that can be used in place of the ether unit.
+var etherSymbols = []string{"Ξ", "ξ"}
+
+// hasMultipleDecimalPoints returns true if the number at the start of the
+// input contains more than one decimal point, e.g. "1.2.3" or "1..5".
+func hasMultipleDecimalPoints(input string) bool {
+	input = strings.TrimPrefix(input, "-")
+	decimalPoints := 0
+	for i := 0; i < len(input) && (isDigit(input[i]) || input[i] == '.'); i++ {
+		if input[i] == '.' {
+			decimalPoints++
+		}
 	}
 
-	// Ensure we don't have a fractional number of Wei.
-	if multiplier.Sign() == 0 {
-		return ErrFractional
+	return decimalPoints > 1
+}
+
+// normaliseWhitespace replaces Unicode whitespace, such as tabs and
+// non-breaking spaces, with ASCII spaces and removes invisible characters,
+// all of which are commonly found in values copied from web pages, PDFs and
+// chat applications.  The invisible characters are zero-width spaces, joiners
+// and non-joiners, word joiners, byte order marks, soft hyphens, bidirectional
+// controls such as left-to-right marks, and other control characters.
+func normaliseWhitespace(input string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			// This includes the non-breaking spaces U+00A0, U+2007 and U+202F.
+			return ' '
+		case r == '\u200b', r == '\u2060', r == '\ufeff', r == '\u00ad':
+			return -1
+		case unicode.Is(unicode.Join_Control, r), unicode.Is(unicode.Bidi_Control, r), unicode.IsControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, input)
+}
+
+// dashReplacer replaces the Unicode minus sign and dashes with an ASCII minus.
+var dashReplacer = strings.NewReplacer(
+	"\u2212", "-",
+	"\u2012", "-",
+	"\u2013", "-",
+	"\u2014", "-",
+	"\u2015", "-",
+)
+
+// normalisePunctuation tidies punctuation commonly found in values taken
+// from free text: Unicode minus signs and dashes are replaced with an ASCII
+// minus, a leading plus is removed, and a single trailing full stop, comma or
+// semicolon following the unit is removed.
+func normalisePunctuation(input string) string {
+	input = strings.TrimSpace(dashReplacer.Replace(input))
+
+	if len(input) > 1 && input[0] == '+' && input[1] != '+' && input[1] != '-' {
+		input = input[1:]
+	}
+
+	if len(input) > 1 && strings.ContainsRune(".,;", rune(input[len(input)-1])) {
+		if r, _ := utf8.DecodeLastRuneInString(input[:len(input)-1]); unicode.IsLetter(r) {
+			input = input[:len(input)-1]
+		}
 	}
 
-	var decResult big.Int
-	decResult.Mul(multiplier, &decVal)
+	return input
+}
 
-	// Add it to the integer result.
-	result.Add(result, &decResult)
+// moveUnitPrefix moves a unit before the number to after it, e.g. "ETH 1.5"
+// becomes "1.5 ETH".  Inputs that do not start with a known unit followed by
+// a number are returned unchanged.
+// This returns ErrInvalidFormat if there is also a unit after the number.
+func moveUnitPrefix(input string) (string, error) {
+	unitEnd := 0
+	for unitEnd < len(input) && isLetter(input[unitEnd]) {
+		unitEnd++
+	}
+	if unitEnd == 0 {
+		return input, nil
+	}
+	unit := input[:unitEnd]
+	if _, exists := lookupUnitPos(unit); !exists {
+		return input, nil
+	}
+	number := normalisePunctuation(input[unitEnd:])
+	if number == "" || !(isDigit(number[0]) || number[0] == '-' || number[0] == '.') {
+		return input, nil
+	}
 
-	return nil
+	suffixStart := len(number)
+	for suffixStart > 0 && isLetter(number[suffixStart-1]) {
+		suffixStart--
+	}
+	hasSuffixUnit := false
+	if suffixStart < len(number) {
+		_, hasSuffixUnit = lookupUnitPos(number[suffixStart:])
+	}
+	for _, symbol := range etherSymbols {
+		hasSuffixUnit = hasSuffixUnit || strings.HasSuffix(number, symbol)
+	}
+	if hasSuffixUnit {
+		return "", wrapError(ErrInvalidFormat, "unit both before and after number in "+strconv.Quote(input))
+	}
+
+	return number + " " + unit, nil
+}
+
+// replaceEtherSymbol replaces an ether symbol before or after the number with
+// the ether unit.
+func replaceEtherSymbol(input string) string {
+	for _, symbol := range etherSymbols {
+		if strings.HasPrefix(input, symbol) {
+			return strings.TrimPrefix(input, symbol) + "ether"
+		}
+		if strings.HasSuffix(input, symbol) {
+			return strings.TrimSuffix(input, symbol) + "ether"
+		}
+	}
+
+	return input
+}
+
+// replaceMicroSign replaces the micro sign in the µETH symbol with its ASCII
+// equivalent "u", as the parser only accepts ASCII units.
+func replaceMicroSign(input string) string {
+	for _, symbol := range []string{"µETH", "μETH"} {
+		if strings.HasSuffix(input, symbol) {
+			return strings.TrimSuffix(input, symbol) + "uETH"
+		}
+	}
+
+	return input
 }
 
-func integerStringToWei(amount string, unit string, result *big.Int) error {
+// removeUnderscores removes underscores used to group digits in the integer
+// part of the input, e.g. "1_000_000".  Underscores must be between two
+// digits, or hexadecimal digits for a hexadecimal number.
+// This returns ErrInvalidFormat if an underscore is in the fractional part of
+// a number, or is not between digits.
+func removeUnderscores(input string) (string, error) {
+	if !strings.Contains(input, "_") {
+		return input, nil
+	}
+
+	trimmed := strings.TrimPrefix(input, "-")
+	isNumberDigit := isDigit
+	if strings.HasPrefix(trimmed, "0x") || strings.HasPrefix(trimmed, "0X") {
+		isNumberDigit = isHexDigit
+	}
+
+	var builder strings.Builder
+	builder.Grow(len(input))
+	inFraction := false
+	for i := 0; i < len(input); i++ {
+		switch {
+		case input[i] == '.':
+			inFraction = true
+		case input[i] == '_':
+			if inFraction {
+				return "", wrapError(ErrInvalidFormat, "underscores not permitted in fractional part")
+			}
+			if i == 0 || i == len(input)-1 || !isNumberDigit(input[i-1]) || !isNumberDigit(input[i+1]) {
+				return "", wrapError(ErrInvalidFormat, "underscores must be between digits")
+			}
+
+			continue
+		case !isDigit(input[i]):
+			// The end of the number; a compound value may have further
+			// numbers.
+			inFraction = false
+		}
+		builder.WriteByte(input[i])
+	}
+
+	return builder.String(), nil
+}
+
+// removeGrouping removes commas used as thousands separators in the integer
+// part of the input, ensuring that they are well-formed.
+func removeGrouping(input string) (string, error) {
+	if !strings.Contains(input, ",") {
+		return input, nil
+	}
+
+	start := 0
+	if strings.HasPrefix(input, "-") {
+		start = 1
+	}
+	end := start
+	for end < len(input) && (isDigit(input[end]) || input[end] == ',') {
+		end++
+	}
+	if strings.Contains(input[end:], ",") {
+		return "", ErrInvalidFormat
+	}
+
+	groups := strings.Split(input[start:end], ",")
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", ErrInvalidFormat
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return "", ErrInvalidFormat
+		}
+	}
+
+	return input[:start] + strings.Join(groups, "") + input[end:], nil
+}
+
+// compoundStringToWei sums number and unit pairs, e.g. "1ether" and
+// "500finney", in to a number of Wei.  Units must be in strictly descending
+// order.  The unit of the first pair is returned.
+func compoundStringToWei(pairs []string, opts *parseOptions) (*big.Int, string, error) {
+	result := new(big.Int)
+	var firstUnit string
+	var prevUnit string
+	var prevMultiplier *big.Int
+	for i, pair := range pairs {
+		value, unit, err := stringToWei(pair, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		// This will never fail because the unit has already been parsed.
+		multiplier, _ := opts.resolver.Multiplier(unit)
+		if i == 0 {
+			firstUnit = unit
+		} else {
+			switch multiplier.Cmp(prevMultiplier) {
+			case 0:
+				return nil, "", wrapError(ErrInvalidFormat, "unit "+unit+" repeats "+prevUnit)
+			case 1:
+				return nil, "", wrapError(ErrInvalidFormat, "unit "+unit+" is larger than preceding unit "+prevUnit)
+			}
+		}
+		result.Add(result, value)
+		prevUnit = unit
+		prevMultiplier = multiplier
+	}
+
+	return result, firstUnit, nil
+}
+
+// maxExponent is the largest absolute exponent accepted in scientific notation.
+const maxExponent = 256
+
+// expandExponent turns a number in scientific notation, e.g. "1.5e9", in to
+// the equivalent number without an exponent, e.g. "1500000000".
+// String manipulation is used to move the decimal point to avoid the
+// inaccuracy of floating point.
+func expandExponent(amount string) (string, error) {
+	expPos := strings.IndexAny(amount, "eE")
+	mantissa := amount[:expPos]
+	exponent, err := strconv.Atoi(amount[expPos+1:])
+	if err != nil || exponent > maxExponent || exponent < -maxExponent {
+		return "", ErrInvalidFormat
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign = "-"
+		mantissa = mantissa[1:]
+	}
+	intPart, decPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && decPart == "" {
+		return "", ErrInvalidFormat
+	}
+
+	digits := intPart + decPart
+	decimalPlace := len(intPart) + exponent
+	switch {
+	case decimalPlace >= len(digits):
+		return sign + digits + strings.Repeat("0", decimalPlace-len(digits)), nil
+	case decimalPlace <= 0:
+		return sign + "0." + strings.Repeat("0", -decimalPlace) + digits, nil
+	default:
+		return sign + digits[:decimalPlace] + "." + digits[decimalPlace:], nil
+	}
+}
+
+// hexStringToWei parses a hexadecimal amount, without its leading "0x",
+// followed by an optional unit.  As some units start with hexadecimal digits
+// the longest hexadecimal amount followed by a valid unit is used.
+func hexStringToWei(input string, opts *parseOptions, result *big.Int) (string, error) {
+	hexEnd := 0
+	for hexEnd < len(input) && isHexDigit(input[hexEnd]) {
+		hexEnd++
+	}
+
+	for ; hexEnd > 0; hexEnd-- {
+		unit := input[hexEnd:]
+		multiplier, err := opts.resolver.Multiplier(unit)
+		if err != nil {
+			continue
+		}
+		number, success := new(big.Int).SetString(input[:hexEnd], 16)
+		if !success {
+			return "", ErrInvalidFormat
+		}
+		result.Mul(number, multiplier)
+
+		return unit, nil
+	}
+
+	return "", ErrInvalidFormat
+}
+
+func integerStringToWei(amount string, unit string, resolver UnitResolver, result *big.Int) error {
 	// Obtain number.
 	number := new(big.Int)
 	_, success := number.SetString(amount, 10)
 	if !success {
-		return fmt.Errorf("%w %s %s", ErrParseFailure, amount, unit)
+		return &ParseError{Amount: amount, Unit: unit, Err: ErrParseFailure}
 	}
 
 	// Obtain multiplier.
-	multiplier, err := UnitToMultiplier(unit)
+	multiplier, err := resolver.Multiplier(unit)
 	if err != nil {
-		return fmt.Errorf("%w %s %s", ErrParseFailure, amount, unit)
+		return unitParseError(amount, unit, err)
 	}
 
 	result.Mul(number, multiplier)
@@ -308,39 +1316,206 @@ var metricUnits = [...]string{
 	"Megaether",
 	"Gigaether",
 	"Teraether",
+	"Petaether",
+	"Exaether",
+	"Zettaether",
+	"Yottaether",
+}
+
+// etherPos is the position of Ether in metricUnits.
+const etherPos = 6
+
+// metricMultipliers are the multipliers for metricUnits, such that
+// metricMultipliers[i] is the number of Wei in one metricUnits[i].
+// These must not be modified.
+var metricMultipliers = func() []*big.Int {
+	multipliers := make([]*big.Int, len(metricUnits))
+	multipliers[0] = big.NewInt(1)
+	for i := 1; i < len(multipliers); i++ {
+		multipliers[i] = new(big.Int).Mul(multipliers[i-1], thousand)
+	}
+
+	return multipliers
+}()
+
+// UnitResolver obtains the multiplier for a unit.
+type UnitResolver interface {
+	// Multiplier returns the number of Wei in one of the given unit.
+	Multiplier(unit string) (*big.Int, error)
+}
+
+// UnitResolverFunc is an adapter to allow the use of ordinary functions as
+// unit resolvers.
+type UnitResolverFunc func(unit string) (*big.Int, error)
+
+// Multiplier calls f(unit).
+func (f UnitResolverFunc) Multiplier(unit string) (*big.Int, error) {
+	return f(unit)
+}
+
+// DefaultUnitResolver is the unit resolver used by StringToWei, which
+// resolves units with UnitToMultiplier.
+var DefaultUnitResolver UnitResolver = UnitResolverFunc(UnitToMultiplier)
+
+// siSymbols are case-sensitive SI symbols for units, with their positions in
+// metricUnits.
+var siSymbols = map[string]int{
+	"kWei": 1,
+	"MWei": 2,
+	"GWei": 3,
+	"uETH": 4,
+	"µETH": 4, // Micro sign.
+	"μETH": 4, // Greek small letter mu.
+	"mETH": 5,
+	"kETH": 7,
 }
 
 // UnitToMultiplier takes the name of an Ethereum unit and returns a multiplier.
-//
-//nolint:cyclop
+// Case-sensitive SI symbols (kWei, MWei, GWei, µETH, uETH, mETH and kETH) are
+// matched exactly before unit names, which are case-insensitive.  As such
+// "mETH" is milliether, but "METH" is not recognised as it could be either
+// milliether or megaether.
+// The symbols "Ξ" and "ξ" are accepted for ether.
+// Historical names are also accepted: babbage and lovelace (10^6 Wei),
+// shannon (10^9 Wei), szabo (10^12 Wei), finney (10^15 Wei), and einstein and
+// grand (10^21 Wei).  The misspelling "szazbo" is accepted for szabo for
+// backwards compatibility.
+// The returned multiplier belongs to the caller, who may modify it.
 func UnitToMultiplier(unit string) (*big.Int, error) {
-	result := big.NewInt(0)
-	switch strings.ToLower(unit) {
-	case "", "wei":
-		result.SetString("1", 10)
-	case "ada", "kwei", "kilowei":
-		result.SetString("1000", 10)
-	case "babbage", "mwei", "megawei":
-		result.SetString("1000000", 10)
-	case "shannon", "gwei", "gigawei":
-		result.SetString("1000000000", 10)
-	case "szazbo", "micro", "microether":
-		result.SetString("1000000000000", 10)
-	case "finney", "milli", "milliether":
-		result.SetString("1000000000000000", 10)
-	case "eth", "ether":
-		result.SetString("1000000000000000000", 10)
-	case "einstein", "kilo", "kiloether":
-		result.SetString("1000000000000000000000", 10)
-	case "mega", "megaether":
-		result.SetString("1000000000000000000000000", 10)
-	case "giga", "gigaether":
-		result.SetString("1000000000000000000000000000", 10)
-	case "tera", "teraether":
-		result.SetString("1000000000000000000000000000000", 10)
-	default:
-		return nil, fmt.Errorf("%w %s", ErrUnknownUnit, unit)
+	if err := checkInputLength(unit, DefaultMaxInputLength); err != nil {
+		return nil, err
+	}
+
+	return multiplierFor(unit)
+}
+
+// multiplierFor returns the multiplier for a unit.  The multiplier is a copy
+// of that in metricMultipliers, so callers may modify it without affecting
+// later conversions.
+func multiplierFor(unit string) (*big.Int, error) {
+	unitPos, exists := lookupUnitPos(unit)
+	if !exists {
+		return nil, newUnknownUnitError(unit)
+	}
+
+	return new(big.Int).Set(metricMultipliers[unitPos]), nil
+}
+
+// lookupUnitPos returns the position in metricUnits of the given unit.
+// See UnitToMultiplier for details of the accepted units.
+func lookupUnitPos(unit string) (int, bool) {
+	if unitPos, exists := siSymbols[unit]; exists {
+		return unitPos, true
+	}
+
+	return unitNamePos(strings.ToLower(unit))
+}
+
+// unitNames are case-insensitive names for units, with their positions in
+// metricUnits.
+var unitNames = map[string]int{
+	"":          0,
+	"wei":       0,
+	"atto":      0,
+	"attoether": 0,
+
+	"ada":        1,
+	"kwei":       1,
+	"kilowei":    1,
+	"femto":      1,
+	"femtoether": 1,
+
+	"babbage":   2,
+	"lovelace":  2,
+	"mwei":      2,
+	"megawei":   2,
+	"pico":      2,
+	"picoether": 2,
+
+	"shannon":   3,
+	"gwei":      3,
+	"gigawei":   3,
+	"nano":      3,
+	"nanoether": 3,
+
+	"szabo": 4,
+	// "szazbo" is a misspelling of "szabo", retained for backwards compatibility.
+	"szazbo":     4,
+	"micro":      4,
+	"microether": 4,
+
+	"finney":     5,
+	"milli":      5,
+	"milliether": 5,
+
+	"eth":   etherPos,
+	"ether": etherPos,
+	// Lower-casing turns the symbol "Ξ" in to "ξ".
+	"ξ": etherPos,
+
+	"einstein":  7,
+	"grand":     7,
+	"kilo":      7,
+	"kiloether": 7,
+
+	"mega":      8,
+	"megaether": 8,
+
+	"giga":      9,
+	"gigaether": 9,
+
+	"tera":      10,
+	"teraether": 10,
+
+	"peta":      11,
+	"petaether": 11,
+
+	"exa":      12,
+	"exaether": 12,
+
+	"zetta":      13,
+	"zettaether": 13,
+
+	"yotta":      14,
+	"yottaether": 14,
+}
+
+// unitNamePos returns the position in metricUnits of the given lower-case
+// unit name.
+func unitNamePos(unit string) (int, bool) {
+	unitPos, exists := unitNames[unit]
+
+	return unitPos, exists
+}
+
+// SameUnit returns true if the two unit names are aliases of the same unit,
+// e.g. "gwei" and "shannon".
+func SameUnit(a, b string) (bool, error) {
+	multiplierA, err := UnitToMultiplier(a)
+	if err != nil {
+		return false, err
+	}
+	multiplierB, err := UnitToMultiplier(b)
+	if err != nil {
+		return false, err
+	}
+
+	return multiplierA.Cmp(multiplierB) == 0, nil
+}
+
+// MultiplierToUnit takes a multiplier and returns the name of the metric
+// Ethereum unit to which it corresponds.
+// It is the inverse of UnitToMultiplier.
+func MultiplierToUnit(multiplier *big.Int) (string, error) {
+	if multiplier == nil || multiplier.Sign() <= 0 {
+		return "", ErrUnknownUnit
+	}
+
+	for i := range metricMultipliers {
+		if metricMultipliers[i].Cmp(multiplier) == 0 {
+			return metricUnits[i], nil
+		}
 	}
 
-	return result, nil
+	return "", wrapErrorValue(ErrUnknownUnit, multiplier.Text(10))
 }
@@ -0,0 +1,78 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricMultipliers(t *testing.T) {
+	require.Len(t, metricMultipliers, len(metricUnits))
+	for i, unit := range metricUnits {
+		t.Run(unit, func(t *testing.T) {
+			multiplier, err := UnitToMultiplier(unit)
+			require.NoError(t, err)
+			require.Equal(t, multiplier, metricMultipliers[i])
+		})
+	}
+	require.Equal(t, "Ether", metricUnits[etherPos])
+}
+
+func TestDecimalStringToWeiNoDigits(t *testing.T) {
+	for _, amount := range []string{".", "-."} {
+		t.Run(amount, func(t *testing.T) {
+			err := decimalStringToWei(amount, "ether", newParseOptions(DefaultUnitResolver), new(big.Int))
+			require.ErrorIs(t, err, ErrInvalidFormat)
+		})
+	}
+}
+
+func TestParseOptionsCombined(t *testing.T) {
+	// A custom resolver does not hide the other options.
+	resolver := UnitResolverFunc(func(unit string) (*big.Int, error) {
+		return multiplierFor(unit)
+	})
+	opts := newParseOptions(resolver)
+	opts.rounding = true
+	opts.mode = RoundHalfUp
+	opts.limitDecimals = true
+	opts.maxDecimals = 2
+	opts.maxLength = 12
+
+	result, _, err := stringToWei("1.25 wei", opts)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1), result)
+
+	result, _, err = stringToWei("(1.75 wei)", opts)
+	require.ErrorIs(t, err, ErrNegative)
+	require.Nil(t, result)
+
+	_, _, err = stringToWei("1.125 wei", opts)
+	require.ErrorIs(t, err, ErrTooManyDecimals)
+
+	_, _, err = stringToWei("1/3 wei", opts)
+	require.ErrorIs(t, err, ErrTooManyDecimals)
+
+	_, _, err = stringToWei("1000000000000 wei", opts)
+	require.ErrorIs(t, err, ErrValueTooLong)
+
+	// Options apply to each part of a compound value.
+	opts.maxLength = DefaultMaxInputLength
+	_, _, err = stringToWei("1 ether 1.125 wei", opts)
+	require.ErrorIs(t, err, ErrTooManyDecimals)
+}
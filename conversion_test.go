@@ -17,7 +17,9 @@ package string2eth_test
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -66,167 +68,825 @@ func _bigInt(input string) *big.Int {
 	return res
 }
 
-func TestStringToWei(t *testing.T) {
-	tests := []struct {
-		input  string
-		result *big.Int
-		err    error
-	}{
-		{ // 0
-			input: "",
-			err:   errors.New("failed to parse empty value"),
-		},
-		{ // 1
-			input:  "1",
-			result: _bigInt("1"),
-		},
-		{ // 2
-			input:  "123456789",
-			result: _bigInt("123456789"),
-		},
-		{ // 3
-			input:  "123456789 Wei",
-			result: _bigInt("123456789"),
-		},
-		{ // 4
-			input:  "1000000000000000000000",
-			result: _bigInt("1000000000000000000000"),
-		},
-		{ // 5
-			input:  "0.024ether",
-			result: _bigInt("24000000000000000"),
-		},
-		{ // 6
-			input:  "85748574 microether",
-			result: _bigInt("85748574000000000000"),
-		},
-		{ // 7
-			input:  "85748574 milliether",
-			result: _bigInt("85748574000000000000000"),
-		},
-		{ // 8
-			input:  "1 ether",
-			result: _bigInt("1000000000000000000"),
-		},
-		{ // 9
-			input:  "1 kiloether",
-			result: _bigInt("1000000000000000000000"),
-		},
-		{ // 10
-			input:  "1 megaether",
-			result: _bigInt("1000000000000000000000000"),
-		},
-		{ // 11
-			input:  "1 gigaether",
-			result: _bigInt("1000000000000000000000000000"),
-		},
-		{ // 12
-			input:  "5000 Teraether",
-			result: _bigInt("5000000000000000000000000000000000"),
-		},
-		{ // 13
-			input:  "0.123 kwei",
-			result: _bigInt("123"),
-		},
-		{ // 14
-			input:  "0.0001 kiloether",
-			result: _bigInt("100000000000000000"),
-		},
-		{ // 15
-			input:  ".0000001 megaether",
-			result: _bigInt("100000000000000000"),
-		},
-		{ // 16
-			input:  "1. Mwei",
-			result: _bigInt("1000000"),
-		},
-		{ // 17
-			input:  "21 Gwei",
-			result: _bigInt("21000000000"),
-		},
-		{ // 18
-			input:  "1000 ",
-			result: _bigInt("1000"),
-		},
-		{ // 19
-			input:  "1000000000000000000000 Wei",
-			result: _bigInt("1000000000000000000000"),
-		},
-		{ // 20
-			input:  "2megawei",
-			result: _bigInt("2000000"),
-		},
-		{ // 21
-			input:  "2.876543megawei",
-			result: _bigInt("2876543"),
-		},
-		{ // 22
-			input: "2.8765432megawei",
-			err:   errors.New("value resulted in fractional number of Wei"),
-		},
-		{ // 23
-			input:  "2 mega wei",
-			result: _bigInt("2000000"),
-		},
-		{ // 24
-			input:  "    2    mega   wei    ",
-			result: _bigInt("2000000"),
-		},
-		{ // 25
-			input: "1000 foo",
-			err:   errors.New("failed to parse 1000 foo"),
-		},
-		{ // 26
-			input:  "2megawei",
-			result: _bigInt("2000000"),
-		},
-		{ // 27
-			input: "1000.5 foo",
-			err:   errors.New("failed to parse 1000.5 foo"),
-		},
-		{ // 28
-			input: "onehundred ether",
-			err:   errors.New("failed to parse  onehundredether"),
-		},
-		{ // 29
-			input: "onehundred.5 ether",
-			err:   errors.New("invalid format"),
-		},
-		{ // 30
-			input:  "0",
-			result: _bigInt("0"),
-		},
-		{ // 31
-			input:  "0 Ether",
-			result: _bigInt("0"),
-		},
-		{ // 32
-			input: "10 wei wei wei",
-			err:   errors.New("failed to parse 10 weiweiwei"),
-		},
-		{ // 33
-			input: "0.1wei",
-			err:   errors.New("value resulted in fractional number of Wei"),
-		},
-		{ // 34
-			input: "-2 wei",
-			err:   errors.New("value resulted in negative number of Wei"),
-		},
-		{ // 35
-			input: "@",
-			err:   errors.New("invalid format"),
-		},
-		{ // 36
-			input:  "5 Shannon",
-			result: _bigInt("5000000000"),
-		},
-		{ // 37
-			input:  "1_000_000 Ether",
-			result: _bigInt("1000000000000000000000000"),
-		},
-	}
+// stringToWeiTests are the tests for StringToWei, shared with other functions
+// that must give identical results.
+var stringToWeiTests = []struct {
+	input  string
+	result *big.Int
+	err    error
+}{
+	{ // 0
+		input: "",
+		err:   errors.New("failed to parse empty value"),
+	},
+	{ // 1
+		input:  "1",
+		result: _bigInt("1"),
+	},
+	{ // 2
+		input:  "123456789",
+		result: _bigInt("123456789"),
+	},
+	{ // 3
+		input:  "123456789 Wei",
+		result: _bigInt("123456789"),
+	},
+	{ // 4
+		input:  "1000000000000000000000",
+		result: _bigInt("1000000000000000000000"),
+	},
+	{ // 5
+		input:  "0.024ether",
+		result: _bigInt("24000000000000000"),
+	},
+	{ // 6
+		input:  "85748574 microether",
+		result: _bigInt("85748574000000000000"),
+	},
+	{ // 7
+		input:  "85748574 milliether",
+		result: _bigInt("85748574000000000000000"),
+	},
+	{ // 8
+		input:  "1 ether",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 9
+		input:  "1 kiloether",
+		result: _bigInt("1000000000000000000000"),
+	},
+	{ // 10
+		input:  "1 megaether",
+		result: _bigInt("1000000000000000000000000"),
+	},
+	{ // 11
+		input:  "1 gigaether",
+		result: _bigInt("1000000000000000000000000000"),
+	},
+	{ // 12
+		input:  "5000 Teraether",
+		result: _bigInt("5000000000000000000000000000000000"),
+	},
+	{ // 13
+		input:  "0.123 kwei",
+		result: _bigInt("123"),
+	},
+	{ // 14
+		input:  "0.0001 kiloether",
+		result: _bigInt("100000000000000000"),
+	},
+	{ // 15
+		input:  ".0000001 megaether",
+		result: _bigInt("100000000000000000"),
+	},
+	{ // 16
+		input:  "1. Mwei",
+		result: _bigInt("1000000"),
+	},
+	{ // 17
+		input:  "21 Gwei",
+		result: _bigInt("21000000000"),
+	},
+	{ // 18
+		input:  "1000 ",
+		result: _bigInt("1000"),
+	},
+	{ // 19
+		input:  "1000000000000000000000 Wei",
+		result: _bigInt("1000000000000000000000"),
+	},
+	{ // 20
+		input:  "2megawei",
+		result: _bigInt("2000000"),
+	},
+	{ // 21
+		input:  "2.876543megawei",
+		result: _bigInt("2876543"),
+	},
+	{ // 22
+		input: "2.8765432megawei",
+		err:   errors.New("value resulted in fractional number of Wei"),
+	},
+	{ // 23
+		input:  "2 mega wei",
+		result: _bigInt("2000000"),
+	},
+	{ // 24
+		input:  "    2    mega   wei    ",
+		result: _bigInt("2000000"),
+	},
+	{ // 25
+		input: "1000 foo",
+		err:   errors.New(`failed to parse "1000 foo" at offset 5 ("foo")`),
+	},
+	{ // 26
+		input:  "2megawei",
+		result: _bigInt("2000000"),
+	},
+	{ // 27
+		input: "1000.5 foo",
+		err:   errors.New(`failed to parse "1000.5 foo" at offset 7 ("foo")`),
+	},
+	{ // 28
+		input: "onehundred ether",
+		err:   errors.New("invalid format: no number in \"onehundred ether\""),
+	},
+	{ // 29
+		input: "onehundred.5 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 30
+		input:  "0",
+		result: _bigInt("0"),
+	},
+	{ // 31
+		input:  "0 Ether",
+		result: _bigInt("0"),
+	},
+	{ // 32
+		input: "10 wei wei wei",
+		err:   errors.New(`failed to parse "10 wei wei wei"`),
+	},
+	{ // 33
+		input: "0.1wei",
+		err:   errors.New("value resulted in fractional number of Wei"),
+	},
+	{ // 34
+		input: "-2 wei",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 35
+		input: "@",
+		err:   errors.New("invalid format"),
+	},
+	{ // 36
+		input:  "5 Shannon",
+		result: _bigInt("5000000000"),
+	},
+	{ // 37
+		input:  "1_000_000 Ether",
+		result: _bigInt("1000000000000000000000000"),
+	},
+	{ // 38
+		input:  "0x1bc16d674ec80000",
+		result: _bigInt("2000000000000000000"),
+	},
+	{ // 39
+		input:  "0X1BC16D674EC80000",
+		result: _bigInt("2000000000000000000"),
+	},
+	{ // 40
+		input:  "0x4ee2d6d415b85acef8100000000",
+		result: _bigInt("100000000000000000000000000000000"),
+	},
+	{ // 41
+		input:  "0x10 gwei",
+		result: _bigInt("16000000000"),
+	},
+	{ // 42
+		input:  "0x1 ether",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 43
+		input:  "0xa ada",
+		result: _bigInt("43738"),
+	},
+	{ // 44
+		input: "0x",
+		err:   errors.New("invalid format"),
+	},
+	{ // 45
+		input: "0xzz",
+		err:   errors.New("invalid format"),
+	},
+	{ // 46
+		input: "0x10 foo",
+		err:   errors.New("invalid format"),
+	},
+	{ // 47
+		input:  "10",
+		result: _bigInt("10"),
+	},
+	{ // 48
+		input:  "1e18",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 49
+		input:  "1.5e9 gwei",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 50
+		input:  "1500000000 gwei",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 51
+		input:  "1E+3 wei",
+		result: _bigInt("1000"),
+	},
+	{ // 52
+		input:  "1e-3 ether",
+		result: _bigInt("1000000000000000"),
+	},
+	{ // 53
+		input:  ".5e1",
+		result: _bigInt("5"),
+	},
+	{ // 54
+		input:  "123.456e3",
+		result: _bigInt("123456"),
+	},
+	{ // 55
+		input:  "1e-18 ether",
+		result: _bigInt("1"),
+	},
+	{ // 56
+		input: "1e-19 ether",
+		err:   errors.New("value resulted in fractional number of Wei"),
+	},
+	{ // 57
+		input: "1e-1",
+		err:   errors.New("value resulted in fractional number of Wei"),
+	},
+	{ // 58
+		input: "-1e18",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 59
+		input: "e5",
+		err:   errors.New("invalid format"),
+	},
+	{ // 60
+		input: "1e1000",
+		err:   errors.New("invalid format"),
+	},
+	{ // 61
+		input:  "1,000,000",
+		result: _bigInt("1000000"),
+	},
+	{ // 62
+		input:  "1,000,000 ether",
+		result: _bigInt("1000000000000000000000000"),
+	},
+	{ // 63
+		input:  "12,345.67 gwei",
+		result: _bigInt("12345670000000"),
+	},
+	{ // 64
+		input:  "12,345.67gwei",
+		result: _bigInt("12345670000000"),
+	},
+	{ // 65
+		input:  "100,000",
+		result: _bigInt("100000"),
+	},
+	{ // 66
+		input: ",5",
+		err:   errors.New("invalid format"),
+	},
+	{ // 67
+		input: "1,00",
+		err:   errors.New("invalid format"),
+	},
+	{ // 68
+		input: "1,000,00.5",
+		err:   errors.New("invalid format"),
+	},
+	{ // 69
+		input: "1000,000",
+		err:   errors.New("invalid format"),
+	},
+	{ // 70
+		input: "1,000.000,5",
+		err:   errors.New("invalid format"),
+	},
+	{ // 71
+		input: "1,5 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 72
+		input: "1,,000",
+		err:   errors.New("invalid format"),
+	},
+	{ // 73
+		input: "-1,000 wei",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 74
+		input:  "Ξ 1.5",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 75
+		input:  "Ξ1.5",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 76
+		input:  "1.5Ξ",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 77
+		input:  "1.5 ξ",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 78
+		input:  "1.5 ETH",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 79
+		input:  "2.1eth",
+		result: _bigInt("2100000000000000000"),
+	},
+	{ // 80
+		input:  "1.5 Eth",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 81
+		input: "Ξ1.5 ether",
+		err:   errors.New(`failed to parse "Ξ1.5 ether"`),
+	},
+	{ // 82
+		input: ".5 foo",
+		err:   errors.New(`failed to parse ".5 foo" at offset 3 ("foo")`),
+	},
+	{ // 83
+		input:  "5 mETH",
+		result: _bigInt("5000000000000000"),
+	},
+	{ // 84
+		input:  "5 µETH",
+		result: _bigInt("5000000000000"),
+	},
+	{ // 85
+		input:  "5 μETH",
+		result: _bigInt("5000000000000"),
+	},
+	{ // 86
+		input:  "5uETH",
+		result: _bigInt("5000000000000"),
+	},
+	{ // 87
+		input:  "2 kETH",
+		result: _bigInt("2000000000000000000000"),
+	},
+	{ // 88
+		input:  "3 GWei",
+		result: _bigInt("3000000000"),
+	},
+	{ // 89
+		input:  "3 MWei",
+		result: _bigInt("3000000"),
+	},
+	{ // 90
+		input:  "3 kWei",
+		result: _bigInt("3000"),
+	},
+	{ // 91
+		input:  "3 mwei",
+		result: _bigInt("3000000"),
+	},
+	{ // 92
+		input: "5 METH",
+		err:   errors.New(`failed to parse "5 METH" at offset 2 ("METH")`),
+	},
+	{ // 93
+		input:  "1.5k ETH",
+		result: _bigInt("1500000000000000000000"),
+	},
+	{ // 94
+		input:  "12K eth",
+		result: _bigInt("12000000000000000000000"),
+	},
+	{ // 95
+		input:  "2.3m ETH",
+		result: _bigInt("2300000000000000000000000"),
+	},
+	{ // 96
+		input:  "2.3M ETH",
+		result: _bigInt("2300000000000000000000000"),
+	},
+	{ // 97
+		input:  "1b gwei",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 98
+		input:  "2.3 mETH",
+		result: _bigInt("2300000000000000"),
+	},
+	{ // 99
+		input:  "2.3mETH",
+		result: _bigInt("2300000000000000"),
+	},
+	{ // 100
+		input:  "5 mwei",
+		result: _bigInt("5000000"),
+	},
+	{ // 101
+		input:  "5mwei",
+		result: _bigInt("5000000"),
+	},
+	{ // 102
+		input:  "5m wei",
+		result: _bigInt("5000000"),
+	},
+	{ // 103
+		input:  "5m gwei",
+		result: _bigInt("5000000000000000"),
+	},
+	{ // 104
+		input:  "1,500k wei",
+		result: _bigInt("1500000"),
+	},
+	{ // 105
+		input: "5k",
+		err:   errors.New(`failed to parse "5k" at offset 1 ("k")`),
+	},
+	{ // 106
+		input: "5k foo",
+		err:   errors.New(`failed to parse "5k foo"`),
+	},
+	{ // 107
+		input:  "1 ether 500 finney",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 108
+		input:  "2 eth 30 gwei 7 wei",
+		result: _bigInt("2000000030000000007"),
+	},
+	{ // 109
+		input:  "1.5 ether 0.5 gwei",
+		result: _bigInt("1500000000500000000"),
+	},
+	{ // 110
+		input: "1 ether 1 eth",
+		err:   errors.New("invalid format: unit eth repeats ether"),
+	},
+	{ // 111
+		input: "500 finney 1 ether",
+		err:   errors.New("invalid format: unit ether is larger than preceding unit finney"),
+	},
+	{ // 112
+		input: "1 ether 500 foo",
+		err:   errors.New(`failed to parse "1 ether 500 foo" at offset 12 ("foo")`),
+	},
+	{ // 113
+		input: "1 ether 0.1 wei",
+		err:   errors.New("value resulted in fractional number of Wei"),
+	},
+	{ // 114
+		input: "1 ether 500",
+		err:   errors.New("invalid format"),
+	},
+	{ // 115
+		input:  "+1 ether",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 116
+		input:  "+1.5 ether",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 117
+		input: "++1 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 118
+		input: "+-1 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 119
+		input: "−1 wei",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 120
+		input: "–2 gwei",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 121
+		input:  "1 gwei.",
+		result: _bigInt("1000000000"),
+	},
+	{ // 122
+		input:  "0.5 eth.",
+		result: _bigInt("500000000000000000"),
+	},
+	{ // 123
+		input:  "2 wei,",
+		result: _bigInt("2"),
+	},
+	{ // 124
+		input:  "3 kwei;",
+		result: _bigInt("3000"),
+	},
+	{ // 125
+		input: "1 gwei..",
+		err:   errors.New("invalid format"),
+	},
+	{ // 126
+		input:  "1.",
+		result: _bigInt("1"),
+	},
+	{ // 127
+		input: "1.2.3 ether",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 128
+		input: "1..5",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 129
+		input: "1..5 ether",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 130
+		input: "..",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 131
+		input: "-1.2.3 ether",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 132
+		input: "1,000.5.5 ether",
+		err:   errors.New("invalid format: multiple decimal points"),
+	},
+	{ // 133
+		input:  "1.5 ether 0.5 finney",
+		result: _bigInt("1500500000000000000"),
+	},
+	{ // 134
+		input: ".",
+		err:   errors.New("invalid format: no number in \".\""),
+	},
+	{ // 135
+		input: "-",
+		err:   errors.New("invalid format: no number in \"-\""),
+	},
+	{ // 136
+		input: "-.",
+		err:   errors.New("invalid format: no number in \"-.\""),
+	},
+	{ // 137
+		input: "ether",
+		err:   errors.New("invalid format: no number in \"ether\""),
+	},
+	{ // 138
+		input: ".ether",
+		err:   errors.New("invalid format: no number in \".ether\""),
+	},
+	{ // 139
+		input: "- ether",
+		err:   errors.New("invalid format: no number in \"- ether\""),
+	},
+	{ // 140
+		input: " . ",
+		err:   errors.New("invalid format: no number in \" . \""),
+	},
+	{ // 141
+		input:  ".5 ether",
+		result: _bigInt("500000000000000000"),
+	},
+	{ // 142
+		input:  "5. ether",
+		result: _bigInt("5000000000000000000"),
+	},
+	{ // 143
+		input: "-0.5 ether",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 144
+		input: "-1.25 gwei",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 145
+		input: "-0.000000000000000001 ether",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 146
+		input: "-.5 ether",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 147
+		input: "-1.0 ether",
+		err:   errors.New("value resulted in negative number of Wei"),
+	},
+	{ // 148
+		input:  "1Ξ",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 149
+		input:  "2.5 Ξ",
+		result: _bigInt("2500000000000000000"),
+	},
+	{ // 150
+		input:  "1.5\u00a0ether",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 151
+		input:  "\t21 gwei",
+		result: _bigInt("21000000000"),
+	},
+	{ // 152
+		input:  "\ufeff1 ether",
+		result: _bigInt("1000000000000000000"),
+	},
+	{ // 153
+		input:  "21\u202fgwei\u200b",
+		result: _bigInt("21000000000"),
+	},
+	{ // 154
+		input:  "1,000\u2007wei\r\n",
+		result: _bigInt("1000"),
+	},
+	{ // 155
+		input:  "1000 ",
+		result: _bigInt("1000"),
+	},
+	{ // 156
+		input: "   ",
+		err:   errors.New("failed to parse empty value"),
+	},
+	{ // 157
+		input: "\t\u00a0\ufeff",
+		err:   errors.New("failed to parse empty value"),
+	},
+	{ // 158
+		input:  "1.5k\tETH",
+		result: _bigInt("1500000000000000000000"),
+	},
+	{ // 159
+		input:  "1/4\tether",
+		result: _bigInt("250000000000000000"),
+	},
+	{ // 160
+		input:  "ETH 1.5",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 161
+		input:  "GWEI 21",
+		result: big.NewInt(21000000000),
+	},
+	{ // 162
+		input:  "ETH1,234.5",
+		result: _bigInt("1234500000000000000000"),
+	},
+	{ // 163
+		input:  "ether 1.5e3",
+		result: _bigInt("1500000000000000000000"),
+	},
+	{ // 164
+		input:  "gwei 0x10",
+		result: big.NewInt(16000000000),
+	},
+	{ // 165
+		input:  "ETH +1/4",
+		result: _bigInt("250000000000000000"),
+	},
+	{ // 166
+		input: "ETH 1.5 gwei",
+		err:   errors.New(`invalid format: unit both before and after number in "ETH 1.5 gwei"`),
+	},
+	{ // 167
+		input: "ether 1 ether",
+		err:   errors.New(`invalid format: unit both before and after number in "ether 1 ether"`),
+	},
+	{ // 168
+		input: "ETH 1.5Ξ",
+		err:   errors.New(`invalid format: unit both before and after number in "ETH 1.5Ξ"`),
+	},
+	{ // 169
+		input:  "1_000 ether",
+		result: _bigInt("1000000000000000000000"),
+	},
+	{ // 170
+		input:  "0xdead_beef",
+		result: big.NewInt(0xdeadbeef),
+	},
+	{ // 171
+		input: "1.0_5 ether",
+		err:   errors.New("invalid format: underscores not permitted in fractional part"),
+	},
+	{ // 172
+		input: "1_00.5_5 ether",
+		err:   errors.New("invalid format: underscores not permitted in fractional part"),
+	},
+	{ // 173
+		input: "1_.5 ether",
+		err:   errors.New("invalid format: underscores must be between digits"),
+	},
+	{ // 174
+		input: "1._5 ether",
+		err:   errors.New("invalid format: underscores not permitted in fractional part"),
+	},
+	{ // 175
+		input: "_1 ether",
+		err:   errors.New("invalid format: underscores must be between digits"),
+	},
+	{ // 176
+		input: "1__000 ether",
+		err:   errors.New("invalid format: underscores must be between digits"),
+	},
+	{ // 177
+		input: "1_ether",
+		err:   errors.New("invalid format: underscores must be between digits"),
+	},
+	{ // 178
+		input:  "1.5 ether 500_000 gwei",
+		result: _bigInt("1500500000000000000"),
+	},
+	{ // 179
+		input:  "+21 gwei",
+		result: big.NewInt(21000000000),
+	},
+	{ // 180
+		input:  "+21gwei",
+		result: big.NewInt(21000000000),
+	},
+	{ // 181
+		input:  " +21 gwei",
+		result: big.NewInt(21000000000),
+	},
+	{ // 182
+		input:  "+0x10",
+		result: big.NewInt(16),
+	},
+	{ // 183
+		input:  "+.5 ether",
+		result: _bigInt("500000000000000000"),
+	},
+	{ // 184
+		input:  "+1e3 wei",
+		result: big.NewInt(1000),
+	},
+	{ // 185
+		input: "-+1 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 186
+		input: "+",
+		err:   errors.New("invalid format"),
+	},
+	{ // 187
+		input: "1+2 ether",
+		err:   errors.New("invalid format"),
+	},
+	{ // 188
+		input:  "\u200e1.5 ETH\u200f",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 189
+		input:  "\u202a0.5 ether\u202c",
+		result: _bigInt("500000000000000000"),
+	},
+	{ // 190
+		input:  "\u20661.5\u2069 ether",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 191
+		input:  "1\u00ad000 gwei",
+		result: big.NewInt(1000000000000),
+	},
+	{ // 192
+		input:  "21\u200cgwei",
+		result: big.NewInt(21000000000),
+	},
+	{ // 193
+		input:  "1.\u200d5 ether",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 194
+		input:  "1.5\u2060 ether",
+		result: _bigInt("1500000000000000000"),
+	},
+	{ // 195
+		input:  "\x001 wei\x7f",
+		result: big.NewInt(1),
+	},
+	{ // 196
+		input: " \u200b\u00ad\u200e ",
+		err:   errors.New("failed to parse empty value"),
+	},
+	{ // 197
+		input: "\u202a\u202c",
+		err:   errors.New("failed to parse empty value"),
+	},
+	{ // 198
+		input:  "1 petaether",
+		result: _bigInt("1000000000000000000000000000000000"),
+	},
+	{ // 199
+		input:  "2 Exaether",
+		result: _bigInt("2000000000000000000000000000000000000"),
+	},
+	{ // 200
+		input:  "1.5 zettaether",
+		result: _bigInt("1500000000000000000000000000000000000000"),
+	},
+	{ // 201
+		input:  "1000 yotta",
+		result: _bigInt("1000000000000000000000000000000000000000000000"),
+	},
+}
 
-	for i, test := range tests {
+func TestStringToWei(t *testing.T) {
+	for i, test := range stringToWeiTests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
 			result, err := string2eth.StringToWei(test.input)
 			if err != nil {
@@ -554,7 +1214,7 @@ func TestWeiToString(t *testing.T) {
 		{ // 61
 			input:     _bigInt("1000000000000000000000000000000000"),
 			canonical: false,
-			result:    "overflow",
+			result:    "1 Petaether",
 		},
 		{ // 62
 			input:     _bigInt(""),
@@ -581,6 +1241,41 @@ func TestWeiToString(t *testing.T) {
 			canonical: true,
 			result:    "0.001 Ether",
 		},
+		{ // 67
+			input:     _bigInt("1500000000000000000000000000000000"),
+			canonical: false,
+			result:    "1.5 Petaether",
+		},
+		{ // 68
+			input:     _bigInt("1000000000000000000000000000000000000"),
+			canonical: false,
+			result:    "1 Exaether",
+		},
+		{ // 69
+			input:     _bigInt("1000000000000000000000000000000000000000"),
+			canonical: false,
+			result:    "1 Zettaether",
+		},
+		{ // 70
+			input:     _bigInt("1000000000000000000000000000000000000000000"),
+			canonical: false,
+			result:    "1 Yottaether",
+		},
+		{ // 71
+			input:     _bigInt("999999999999999999999999999999999999999999999"),
+			canonical: false,
+			result:    "999.999999999999999999999999999999999999999999 Yottaether",
+		},
+		{ // 72
+			input:     _bigInt("1000000000000000000000000000000000000000000000"),
+			canonical: false,
+			result:    "overflow",
+		},
+		{ // 73
+			input:     _bigInt("1000000000000000000000000000000000000000000000"),
+			canonical: true,
+			result:    "1000000000000000000000000000 Ether",
+		},
 	}
 
 	for i, test := range tests {
@@ -589,6 +1284,121 @@ func TestWeiToString(t *testing.T) {
 	}
 }
 
+func TestWeiToStringNegative(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Int
+		standard bool
+		result   string
+	}{
+		{
+			name:     "OneEther",
+			input:    _bigInt("-1000000000000000000"),
+			standard: true,
+			result:   "-1 Ether",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(-1),
+			result: "-1 Wei",
+		},
+		{
+			name:   "KWei",
+			input:  big.NewInt(-1234),
+			result: "-1.234 KWei",
+		},
+		{
+			name:     "BelowOneGWei",
+			input:    big.NewInt(-999999999),
+			standard: true,
+			result:   "-999.999999 MWei",
+		},
+		{
+			name:     "Microether",
+			input:    big.NewInt(-1000000000000),
+			standard: false,
+			result:   "-1 Microether",
+		},
+		{
+			name:     "MicroetherStandard",
+			input:    big.NewInt(-1000000000000),
+			standard: true,
+			result:   "-1000 GWei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToString(test.input, test.standard))
+			// The output of the absolute value is the same without the sign.
+			abs := new(big.Int).Abs(test.input)
+			require.Equal(t, strings.TrimPrefix(test.result, "-"), string2eth.WeiToString(abs, test.standard))
+		})
+	}
+}
+
+func TestSignedRoundTrip(t *testing.T) {
+	for _, input := range []string{"-1.5 ether", "-1 wei", "-0.999999999 gwei", "-21 gwei", "-1234.5678 ether"} {
+		t.Run(input, func(t *testing.T) {
+			value, err := string2eth.StringToWeiSigned(input)
+			require.NoError(t, err)
+			require.Equal(t, -1, value.Sign())
+
+			output := string2eth.WeiToString(value, false)
+			require.True(t, strings.HasPrefix(output, "-"))
+			roundTrip, err := string2eth.StringToWeiSigned(output)
+			require.NoError(t, err)
+			require.Equal(t, value, roundTrip)
+		})
+	}
+}
+
+func TestGWeiToWeiUint64(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  uint64
+		result string
+	}{
+		{
+			name:   "Zero",
+			input:  0,
+			result: "0",
+		},
+		{
+			name:   "One",
+			input:  1,
+			result: "1000000000",
+		},
+		{
+			name:   "Typical",
+			input:  21,
+			result: "21000000000",
+		},
+		{
+			name:   "NearMax",
+			input:  math.MaxUint64 - 1,
+			result: "18446744073709551614000000000",
+		},
+		{
+			name:   "Max",
+			input:  math.MaxUint64,
+			result: "18446744073709551615000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.GWeiToWei(test.input)
+			require.Equal(t, test.result, result.Text(10))
+		})
+	}
+
+	// Each result must be independent.
+	first := string2eth.GWeiToWei(1)
+	first.SetInt64(5)
+	require.Equal(t, "1000000000", string2eth.GWeiToWei(1).Text(10))
+}
+
 func TestGWeiToString(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -623,15 +1433,33 @@ func TestStringToGWei(t *testing.T) {
 			input:  "0",
 			result: 0,
 		},
+		{
+			name:   "SubGWei",
+			input:  "0.7 gwei",
+			result: 0,
+		},
+		{
+			name:   "Floored",
+			input:  "1999999999 wei",
+			result: 1,
+		},
 		{
 			name:  "Invalid",
 			input: "@",
 			err:   errors.New("invalid format"),
 		},
+		{
+			name:  "Overflow",
+			input: "18446744073709551616 gwei",
+			err:   errors.New("value overflows: 18446744073709551616 GWei is above the maximum of 18446744073709551615 GWei"),
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			result, err := string2eth.StringToGWei(test.input)
+			floorResult, floorErr := string2eth.StringToGWeiRounded(test.input, string2eth.RoundFloor)
+			require.Equal(t, floorResult, result)
+			require.Equal(t, floorErr, err)
 			if test.err != nil {
 				require.NotNil(t, err)
 				require.Equal(t, test.err.Error(), err.Error())
@@ -643,6 +1471,113 @@ func TestStringToGWei(t *testing.T) {
 	}
 }
 
+func TestStringToGWeiRounded(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		mode   string2eth.RoundingMode
+		result uint64
+		err    error
+	}{
+		{
+			name:   "FloorSubGWei",
+			input:  "0.7 gwei",
+			mode:   string2eth.RoundFloor,
+			result: 0,
+		},
+		{
+			name:   "CeilSubGWei",
+			input:  "0.7 gwei",
+			mode:   string2eth.RoundCeiling,
+			result: 1,
+		},
+		{
+			name:   "HalfUpSubGWei",
+			input:  "0.7 gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: 1,
+		},
+		{
+			name:   "HalfEvenSubGWei",
+			input:  "0.7 gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: 1,
+		},
+		{
+			name:   "FloorWei",
+			input:  "1999999999 wei",
+			mode:   string2eth.RoundFloor,
+			result: 1,
+		},
+		{
+			name:   "CeilWei",
+			input:  "1999999999 wei",
+			mode:   string2eth.RoundCeiling,
+			result: 2,
+		},
+		{
+			name:   "HalfUpHalf",
+			input:  "2.5 gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: 3,
+		},
+		{
+			name:   "HalfEvenHalf",
+			input:  "2.5 gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: 2,
+		},
+		{
+			name:   "HalfEvenHalfOdd",
+			input:  "3.5 gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: 4,
+		},
+		{
+			name:   "HalfUpBelowHalf",
+			input:  "2.499999999 gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: 2,
+		},
+		{
+			name:   "CeilExact",
+			input:  "2 gwei",
+			mode:   string2eth.RoundCeiling,
+			result: 2,
+		},
+		{
+			name:   "FloorMaximum",
+			input:  "18446744073709551615.5 gwei",
+			mode:   string2eth.RoundFloor,
+			result: 18446744073709551615,
+		},
+		{
+			name:  "CeilOverflow",
+			input: "18446744073709551615.5 gwei",
+			mode:  string2eth.RoundCeiling,
+			err:   string2eth.ErrOverflow,
+		},
+		{
+			name:  "Invalid",
+			input: "@",
+			mode:  string2eth.RoundCeiling,
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToGWeiRounded(test.input, test.mode)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
 func TestWeiToGWeiString(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -717,3 +1652,2005 @@ func TestWeiToGWeiString(t *testing.T) {
 		})
 	}
 }
+
+func TestWeiToGWeiStringRounded(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Int
+		decimals int
+		result   string
+	}{
+		{
+			name:     "Nil",
+			decimals: 2,
+			result:   "0",
+		},
+		{
+			name:     "Zero",
+			input:    big.NewInt(0),
+			decimals: 2,
+			result:   "0 GWei",
+		},
+		{
+			name:     "TwoDecimals",
+			input:    big.NewInt(999000050000),
+			decimals: 2,
+			result:   "999 GWei",
+		},
+		{
+			name:     "FourDecimals",
+			input:    big.NewInt(999000050000),
+			decimals: 4,
+			result:   "999.0001 GWei",
+		},
+		{
+			name:     "BelowHalf",
+			input:    big.NewInt(1234999999),
+			decimals: 2,
+			result:   "1.23 GWei",
+		},
+		{
+			name:     "Half",
+			input:    big.NewInt(1235000000),
+			decimals: 2,
+			result:   "1.24 GWei",
+		},
+		{
+			name:     "CarryToInteger",
+			input:    big.NewInt(999995000000),
+			decimals: 2,
+			result:   "1000 GWei",
+		},
+		{
+			name:     "ZeroDecimals",
+			input:    big.NewInt(1500000000),
+			decimals: 0,
+			result:   "2 GWei",
+		},
+		{
+			name:     "NegativeDecimals",
+			input:    big.NewInt(1400000000),
+			decimals: -1,
+			result:   "1 GWei",
+		},
+		{
+			name:     "SubGWeiToZero",
+			input:    big.NewInt(4999999),
+			decimals: 2,
+			result:   "0 GWei",
+		},
+		{
+			name:     "FullPrecision",
+			input:    big.NewInt(1000000001),
+			decimals: 9,
+			result:   "1.000000001 GWei",
+		},
+		{
+			name:     "BeyondFullPrecision",
+			input:    big.NewInt(1000000001),
+			decimals: 12,
+			result:   "1.000000001 GWei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToGWeiStringRounded(test.input, test.decimals))
+		})
+	}
+}
+
+func TestStringToWeiDecimalBoundaries(t *testing.T) {
+	units := []struct {
+		name     string
+		decimals int
+	}{
+		{name: "wei", decimals: 0},
+		{name: "kwei", decimals: 3},
+		{name: "mwei", decimals: 6},
+		{name: "gwei", decimals: 9},
+		{name: "microether", decimals: 12},
+		{name: "milliether", decimals: 15},
+		{name: "ether", decimals: 18},
+		{name: "kiloether", decimals: 21},
+		{name: "megaether", decimals: 24},
+		{name: "gigaether", decimals: 27},
+		{name: "teraether", decimals: 30},
+	}
+
+	for _, unit := range units {
+		t.Run(unit.name, func(t *testing.T) {
+			// Smallest decimal that resolves to a whole number of Wei.
+			exact := "1.0"
+			if unit.decimals > 0 {
+				exact = "0." + strings.Repeat("0", unit.decimals-1) + "1"
+			}
+			result, err := string2eth.StringToWei(fmt.Sprintf("%s %s", exact, unit.name))
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(1), result)
+
+			// One more digit results in a fractional number of Wei.
+			fractional := "0." + strings.Repeat("0", unit.decimals) + "1"
+			_, err = string2eth.StringToWei(fmt.Sprintf("%s %s", fractional, unit.name))
+			require.ErrorIs(t, err, string2eth.ErrFractional)
+		})
+	}
+
+	result, err := string2eth.StringToWei("100.5 kwei")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100500), result)
+}
+
+func BenchmarkStringToWei(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := string2eth.StringToWei("21 Gwei")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestToWeiString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result string
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:   "Zero",
+			input:  "0",
+			result: "0",
+		},
+		{
+			name:   "ZeroEther",
+			input:  "0 ether",
+			result: "0",
+		},
+		{
+			name:   "Wei",
+			input:  "12345",
+			result: "12345",
+		},
+		{
+			name:   "GWei",
+			input:  "21 gwei",
+			result: "21000000000",
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ether",
+			result: "1500000000000000000",
+		},
+		{
+			name:   "Teraether",
+			input:  "1 tera",
+			result: "1000000000000000000000000000000",
+		},
+		{
+			name:  "Fractional",
+			input: "0.1 wei",
+			err:   "value resulted in fractional number of Wei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.ToWeiString(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestMultiplierToUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		multiplier *big.Int
+		result     string
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "unknown unit",
+		},
+		{
+			name:       "Zero",
+			multiplier: big.NewInt(0),
+			err:        "unknown unit",
+		},
+		{
+			name:       "Negative",
+			multiplier: big.NewInt(-1000),
+			err:        "unknown unit",
+		},
+		{
+			name:       "Wei",
+			multiplier: big.NewInt(1),
+			result:     "Wei",
+		},
+		{
+			name:       "KWei",
+			multiplier: big.NewInt(1000),
+			result:     "KWei",
+		},
+		{
+			name:       "MWei",
+			multiplier: big.NewInt(1000000),
+			result:     "MWei",
+		},
+		{
+			name:       "GWei",
+			multiplier: big.NewInt(1000000000),
+			result:     "GWei",
+		},
+		{
+			name:       "Microether",
+			multiplier: big.NewInt(1000000000000),
+			result:     "Microether",
+		},
+		{
+			name:       "Milliether",
+			multiplier: big.NewInt(1000000000000000),
+			result:     "Milliether",
+		},
+		{
+			name:       "Ether",
+			multiplier: big.NewInt(1000000000000000000),
+			result:     "Ether",
+		},
+		{
+			name:       "Kiloether",
+			multiplier: _bigInt("1000000000000000000000"),
+			result:     "Kiloether",
+		},
+		{
+			name:       "Megaether",
+			multiplier: _bigInt("1000000000000000000000000"),
+			result:     "Megaether",
+		},
+		{
+			name:       "Gigaether",
+			multiplier: _bigInt("1000000000000000000000000000"),
+			result:     "Gigaether",
+		},
+		{
+			name:       "Teraether",
+			multiplier: _bigInt("1000000000000000000000000000000"),
+			result:     "Teraether",
+		},
+		{
+			name:       "Petaether",
+			multiplier: _bigInt("1000000000000000000000000000000000"),
+			result:     "Petaether",
+		},
+		{
+			name:       "Exaether",
+			multiplier: _bigInt("1000000000000000000000000000000000000"),
+			result:     "Exaether",
+		},
+		{
+			name:       "Zettaether",
+			multiplier: _bigInt("1000000000000000000000000000000000000000"),
+			result:     "Zettaether",
+		},
+		{
+			name:       "Yottaether",
+			multiplier: _bigInt("1000000000000000000000000000000000000000000"),
+			result:     "Yottaether",
+		},
+		{
+			name:       "NotPowerOfTen",
+			multiplier: big.NewInt(5000),
+			err:        "unknown unit 5000",
+		},
+		{
+			name:       "NotPowerOfThousand",
+			multiplier: big.NewInt(100),
+			err:        "unknown unit 100",
+		},
+		{
+			name:       "TooLarge",
+			multiplier: _bigInt("1000000000000000000000000000000000000000000000"),
+			err:        "unknown unit 1000000000000000000000000000000000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.MultiplierToUnit(test.multiplier)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, string2eth.ErrUnknownUnit)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+				multiplier, err := string2eth.UnitToMultiplier(result)
+				require.NoError(t, err)
+				require.Equal(t, test.multiplier, multiplier)
+			}
+		})
+	}
+}
+
+func TestExceedsGWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		wei    *big.Int
+		limit  uint64
+		result bool
+	}{
+		{
+			name:   "Nil",
+			limit:  1,
+			result: false,
+		},
+		{
+			name:   "ZeroLimit",
+			wei:    big.NewInt(1),
+			limit:  0,
+			result: true,
+		},
+		{
+			name:   "BelowLimit",
+			wei:    big.NewInt(20999999999),
+			limit:  21,
+			result: false,
+		},
+		{
+			name:   "AtLimit",
+			wei:    big.NewInt(21000000000),
+			limit:  21,
+			result: false,
+		},
+		{
+			name:   "AboveLimit",
+			wei:    big.NewInt(21000000001),
+			limit:  21,
+			result: true,
+		},
+		{
+			name:   "MaxLimit",
+			wei:    _bigInt("18446744073709551615000000000"),
+			limit:  18446744073709551615,
+			result: false,
+		},
+		{
+			name:   "AboveMaxLimit",
+			wei:    _bigInt("18446744073709551615000000001"),
+			limit:  18446744073709551615,
+			result: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.ExceedsGWei(test.wei, test.limit))
+		})
+	}
+}
+
+func TestWeiToStringEngineering(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+	}{
+		{
+			name:   "Nil",
+			result: "0 wei",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			result: "0 wei",
+		},
+		{
+			name:   "1",
+			input:  big.NewInt(1),
+			result: "1 wei",
+		},
+		{
+			name:   "999",
+			input:  big.NewInt(999),
+			result: "999 wei",
+		},
+		{
+			name:   "1000",
+			input:  big.NewInt(1000),
+			result: "1e3 wei",
+		},
+		{
+			name:   "1234",
+			input:  big.NewInt(1234),
+			result: "1.234e3 wei",
+		},
+		{
+			name:   "15GWei",
+			input:  big.NewInt(15000000000),
+			result: "15e9 wei",
+		},
+		{
+			name:   "150GWei",
+			input:  big.NewInt(150000000000),
+			result: "150e9 wei",
+		},
+		{
+			name:   "1.5Ether",
+			input:  big.NewInt(1500000000000000000),
+			result: "1.5e18 wei",
+		},
+		{
+			name:   "1Ether1Wei",
+			input:  big.NewInt(1000000000000000001),
+			result: "1.000000000000000001e18 wei",
+		},
+		{
+			name:   "Teraether",
+			input:  _bigInt("1000000000000000000000000000000"),
+			result: "1e30 wei",
+		},
+		{
+			name:   "BeyondTeraether",
+			input:  _bigInt("12000000000000000000000000000000000"),
+			result: "12e33 wei",
+		},
+		{
+			name:   "Negative",
+			input:  big.NewInt(-1500000000000000000),
+			result: "-1.5e18 wei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringEngineering(test.input)
+			require.Equal(t, test.result, result)
+
+			// Ensure that the result round-trips.
+			if test.input != nil && test.input.Sign() >= 0 {
+				wei, err := string2eth.StringToWei(result)
+				require.NoError(t, err)
+				require.Equal(t, test.input, wei)
+			}
+		})
+	}
+}
+
+// tokenResolver is a unit resolver for a custom unit system.
+type tokenResolver struct{}
+
+func (tokenResolver) Multiplier(unit string) (*big.Int, error) {
+	switch strings.ToLower(unit) {
+	case "", "base":
+		return big.NewInt(1), nil
+	case "token":
+		return big.NewInt(1000000), nil
+	case "dozen":
+		return big.NewInt(12), nil
+	default:
+		return nil, fmt.Errorf("%w %s", string2eth.ErrUnknownUnit, unit)
+	}
+}
+
+func TestWeiToStringScientific(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *big.Int
+		sigFigs int
+		result  string
+	}{
+		{
+			name:    "Nil",
+			sigFigs: 5,
+			result:  "0 Ether",
+		},
+		{
+			name:    "Zero",
+			input:   big.NewInt(0),
+			sigFigs: 5,
+			result:  "0 Ether",
+		},
+		{
+			name:    "OneWei",
+			input:   big.NewInt(1),
+			sigFigs: 5,
+			result:  "1e-18 Ether",
+		},
+		{
+			name:    "OneGWei",
+			input:   big.NewInt(1000000000),
+			sigFigs: 5,
+			result:  "1e-9 Ether",
+		},
+		{
+			name:    "OneEther",
+			input:   _bigInt("1000000000000000000"),
+			sigFigs: 5,
+			result:  "1 Ether",
+		},
+		{
+			name:    "FractionalEther",
+			input:   _bigInt("1500000000000000000"),
+			sigFigs: 5,
+			result:  "1.5 Ether",
+		},
+		{
+			name:    "Megaether",
+			input:   _bigInt("1234500000000000000000000"),
+			sigFigs: 5,
+			result:  "1.2345e6 Ether",
+		},
+		{
+			name:    "Rounded",
+			input:   _bigInt("1234560000000000000000000"),
+			sigFigs: 5,
+			result:  "1.2346e6 Ether",
+		},
+		{
+			name:    "RoundedDown",
+			input:   _bigInt("1234540000000000000000000"),
+			sigFigs: 5,
+			result:  "1.2345e6 Ether",
+		},
+		{
+			name:    "RoundedCarry",
+			input:   _bigInt("999999000000000000"),
+			sigFigs: 3,
+			result:  "1 Ether",
+		},
+		{
+			name:    "Teraether",
+			input:   _bigInt("1000000000000000000000000000000"),
+			sigFigs: 5,
+			result:  "1e12 Ether",
+		},
+		{
+			name:    "BeyondTeraether",
+			input:   _bigInt("123456789000000000000000000000000000000000000000000"),
+			sigFigs: 4,
+			result:  "1.235e32 Ether",
+		},
+		{
+			name:    "ZeroSigFigs",
+			input:   _bigInt("2500000000000000000"),
+			sigFigs: 0,
+			result:  "3 Ether",
+		},
+		{
+			name:    "Negative",
+			input:   _bigInt("-1234500000000000"),
+			sigFigs: 3,
+			result:  "-1.23e-3 Ether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToStringScientific(test.input, test.sigFigs))
+		})
+	}
+}
+
+func TestStringToWeiWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		resolver string2eth.UnitResolver
+		result   *big.Int
+		err      string
+	}{
+		{
+			name:     "NilResolver",
+			input:    "1 gwei",
+			resolver: nil,
+			result:   big.NewInt(1000000000),
+		},
+		{
+			name:     "DefaultResolver",
+			input:    "1 gwei",
+			resolver: string2eth.DefaultUnitResolver,
+			result:   big.NewInt(1000000000),
+		},
+		{
+			name:     "CustomBase",
+			input:    "12345",
+			resolver: tokenResolver{},
+			result:   big.NewInt(12345),
+		},
+		{
+			name:     "CustomUnit",
+			input:    "1.5 token",
+			resolver: tokenResolver{},
+			result:   big.NewInt(1500000),
+		},
+		{
+			name:     "CustomUnitHex",
+			input:    "0x10 token",
+			resolver: tokenResolver{},
+			result:   big.NewInt(16000000),
+		},
+		{
+			name:     "CustomUnitFractional",
+			input:    "0.0000001 token",
+			resolver: tokenResolver{},
+			err:      "value resulted in fractional number of Wei",
+		},
+		{
+			name:     "NonDecimalMultiplier",
+			input:    "2.5 dozen",
+			resolver: tokenResolver{},
+			result:   big.NewInt(30),
+		},
+		{
+			name:     "NonDecimalMultiplierFractional",
+			input:    "0.1 dozen",
+			resolver: tokenResolver{},
+			err:      "value resulted in fractional number of Wei",
+		},
+		{
+			name:     "BuiltInUnitNotResolved",
+			input:    "1 ether",
+			resolver: tokenResolver{},
+			err:      `failed to parse "1 ether" at offset 2 ("ether")`,
+		},
+		{
+			name:  "Func",
+			input: "3 x",
+			resolver: string2eth.UnitResolverFunc(func(unit string) (*big.Int, error) {
+				return big.NewInt(7), nil
+			}),
+			result: big.NewInt(21),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiWith(test.input, test.resolver)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestStringToWeiWithDefaultUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		defaultUnit string
+		result      *big.Int
+		err         string
+	}{
+		{
+			name:        "Integer",
+			input:       "2",
+			defaultUnit: "ether",
+			result:      _bigInt("2000000000000000000"),
+		},
+		{
+			name:        "Decimal",
+			input:       "0.5",
+			defaultUnit: "ether",
+			result:      _bigInt("500000000000000000"),
+		},
+		{
+			name:        "Hex",
+			input:       "0x10",
+			defaultUnit: "gwei",
+			result:      big.NewInt(16000000000),
+		},
+		{
+			name:        "Exponent",
+			input:       "1.5e3",
+			defaultUnit: "gwei",
+			result:      big.NewInt(1500000000000),
+		},
+		{
+			name:        "Fraction",
+			input:       "1/4",
+			defaultUnit: "ether",
+			result:      _bigInt("250000000000000000"),
+		},
+		{
+			name:        "ExplicitUnit",
+			input:       "0.5 gwei",
+			defaultUnit: "ether",
+			result:      big.NewInt(500000000),
+		},
+		{
+			name:        "ExplicitWei",
+			input:       "5 wei",
+			defaultUnit: "ether",
+			result:      big.NewInt(5),
+		},
+		{
+			name:        "Fractional",
+			input:       "0.5",
+			defaultUnit: "wei",
+			err:         "value resulted in fractional number of Wei",
+		},
+		{
+			name:        "Negative",
+			input:       "-1",
+			defaultUnit: "ether",
+			err:         "value resulted in negative number of Wei",
+		},
+		{
+			name:        "UnknownDefaultUnit",
+			input:       "1 ether",
+			defaultUnit: "foo",
+			err:         "unknown unit foo",
+		},
+		{
+			name:        "UnknownUnit",
+			input:       "1 foo",
+			defaultUnit: "ether",
+			err:         `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiWithDefaultUnit(test.input, test.defaultUnit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestStringToWeiWithUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		unit   string
+		err    string
+	}{
+		{
+			name:   "GWei",
+			input:  "21 Gwei",
+			result: big.NewInt(21000000000),
+			unit:   "GWei",
+		},
+		{
+			name:   "NoUnit",
+			input:  "1000",
+			result: big.NewInt(1000),
+			unit:   "Wei",
+		},
+		{
+			name:   "Alias",
+			input:  "1.5 finney",
+			result: big.NewInt(1500000000000000),
+			unit:   "Milliether",
+		},
+		{
+			name:   "EtherSymbol",
+			input:  "\u039e2",
+			result: _bigInt("2000000000000000000"),
+			unit:   "Ether",
+		},
+		{
+			name:   "Hex",
+			input:  "0x10 kwei",
+			result: big.NewInt(16000),
+			unit:   "KWei",
+		},
+		{
+			name:   "Compound",
+			input:  "1 ether 500 finney",
+			result: _bigInt("1500000000000000000"),
+			unit:   "Ether",
+		},
+		{
+			name:  "Invalid",
+			input: "1 foo",
+			err:   `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, unit, err := string2eth.StringToWeiWithUnit(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+				require.Equal(t, test.unit, unit)
+			}
+		})
+	}
+}
+
+func TestStringToWeiSigned(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    string
+	}{
+		{
+			name:   "Positive",
+			input:  "1.5 ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Negative",
+			input:  "-2 gwei",
+			result: big.NewInt(-2000000000),
+		},
+		{
+			name:   "NegativeDecimal",
+			input:  "-0.5 ether",
+			result: _bigInt("-500000000000000000"),
+		},
+		{
+			name:   "NegativeDecimalWithInteger",
+			input:  "-1.25 gwei",
+			result: big.NewInt(-1250000000),
+		},
+		{
+			name:   "NegativeOneWei",
+			input:  "-0.000000000000000001 ether",
+			result: big.NewInt(-1),
+		},
+		{
+			name:   "NegativeNoInteger",
+			input:  "-.5 ether",
+			result: _bigInt("-500000000000000000"),
+		},
+		{
+			name:   "NegativeTrailingZero",
+			input:  "-1.0 ether",
+			result: _bigInt("-1000000000000000000"),
+		},
+		{
+			name:   "NegativeExponent",
+			input:  "-1.5e3 gwei",
+			result: big.NewInt(-1500000000000),
+		},
+		{
+			name:   "Parenthesised",
+			input:  "(1.5 ether)",
+			result: _bigInt("-1500000000000000000"),
+		},
+		{
+			name:   "ParenthesisedPadded",
+			input:  " ( 21 gwei ) ",
+			result: big.NewInt(-21000000000),
+		},
+		{
+			name:   "ParenthesisedNoUnit",
+			input:  "(1000)",
+			result: big.NewInt(-1000),
+		},
+		{
+			name:   "UnicodeMinus",
+			input:  "\u22121 wei",
+			result: big.NewInt(-1),
+		},
+		{
+			name:   "FigureDash",
+			input:  "\u20122 gwei.",
+			result: big.NewInt(-2000000000),
+		},
+		{
+			name:   "Plus",
+			input:  "+1 wei",
+			result: big.NewInt(1),
+		},
+		{
+			name:   "NegativeRational",
+			input:  "-1/4 ether",
+			result: _bigInt("-250000000000000000"),
+		},
+		{
+			name:   "NegativeVulgarFraction",
+			input:  "-½ ether",
+			result: _bigInt("-500000000000000000"),
+		},
+		{
+			name:   "NegativeHex",
+			input:  "-0x10",
+			result: big.NewInt(-16),
+		},
+		{
+			name:   "NegativeCompound",
+			input:  "-1 ether 500 finney",
+			result: _bigInt("-1500000000000000000"),
+		},
+		{
+			name:   "NegativeMagnitude",
+			input:  "-1.5k gwei",
+			result: big.NewInt(-1500000000000),
+		},
+		{
+			name:   "NegativeUnitPrefix",
+			input:  "-ETH 1.5",
+			result: _bigInt("-1500000000000000000"),
+		},
+		{
+			name:  "DoubleNegative",
+			input: "--1 ether",
+			err:   "value resulted in negative number of Wei",
+		},
+		{
+			name:  "ParenthesisedNegative",
+			input: "(-1 ether)",
+			err:   "value resulted in negative number of Wei",
+		},
+		{
+			name:  "Unopened",
+			input: "1.5 ether)",
+			err:   "invalid format: unbalanced or nested parentheses",
+		},
+		{
+			name:  "Unclosed",
+			input: "(1.5 ether",
+			err:   "invalid format: unbalanced or nested parentheses",
+		},
+		{
+			name:  "Nested",
+			input: "((1.5 ether))",
+			err:   "invalid format: unbalanced or nested parentheses",
+		},
+		{
+			name:  "Empty",
+			input: "()",
+			err:   "failed to parse empty value",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiSigned(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestStringToWeiParenthesised(t *testing.T) {
+	_, err := string2eth.StringToWei("(1.5 ether)")
+	require.ErrorIs(t, err, string2eth.ErrNegative)
+	require.EqualError(t, err, "value resulted in negative number of Wei: parentheses denote a negative value")
+}
+
+func TestSubMicroetherAliases(t *testing.T) {
+	tests := []struct {
+		alias    string
+		standard string
+	}{
+		{alias: "1 nanoether", standard: "1 gwei"},
+		{alias: "1 nano", standard: "1 gwei"},
+		{alias: "5nanoether", standard: "5 gwei"},
+		{alias: "1.5 NanoEther", standard: "1.5 gwei"},
+		{alias: "1 picoether", standard: "1 mwei"},
+		{alias: "1 pico", standard: "1 mwei"},
+		{alias: "1 femtoether", standard: "1 kwei"},
+		{alias: "1 FEMTO", standard: "1 kwei"},
+		{alias: "1 attoether", standard: "1 wei"},
+		{alias: "1 atto", standard: "1 wei"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.alias, func(t *testing.T) {
+			expected, err := string2eth.StringToWei(test.standard)
+			require.NoError(t, err)
+			result, err := string2eth.StringToWei(test.alias)
+			require.NoError(t, err)
+			require.Equal(t, expected, result)
+		})
+	}
+
+	_, err := string2eth.StringToWei("0.1 attoether")
+	require.ErrorIs(t, err, string2eth.ErrFractional)
+}
+
+func TestStringToGWeiExact(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result uint64
+		err    error
+	}{
+		{
+			name:   "Zero",
+			input:  "0",
+			result: 0,
+		},
+		{
+			name:   "GWei",
+			input:  "2 gwei",
+			result: 2,
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ether",
+			result: 1500000000,
+		},
+		{
+			name:   "ExactWei",
+			input:  "3000000000 wei",
+			result: 3,
+		},
+		{
+			name:   "ExactEther",
+			input:  "0.000000001 ether",
+			result: 1,
+		},
+		{
+			name:  "Fractional",
+			input: "2000000001 wei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "FractionalOneGWei",
+			input: "1000000001 wei",
+			err:   string2eth.ErrPrecisionLoss,
+		},
+		{
+			name:  "FractionalGWei",
+			input: "1.5 gwei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:   "Maximum",
+			input:  "18446744073709551615 gwei",
+			result: 18446744073709551615,
+		},
+		{
+			name:  "Overflow",
+			input: "100000000000 ether",
+			err:   string2eth.ErrOverflow,
+		},
+		{
+			name:  "Invalid",
+			input: "@",
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToGWeiExact(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+				require.Zero(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestStringToGWeiExactRemainder(t *testing.T) {
+	_, err := string2eth.StringToGWeiExact("1000000001 wei")
+	require.EqualError(t, err, "value would lose precision: 1 Wei below 1 GWei would be discarded")
+	require.ErrorIs(t, err, string2eth.ErrPrecisionLoss)
+	require.ErrorIs(t, err, string2eth.ErrFractional)
+
+	_, err = string2eth.StringToGWeiExact("1.5 gwei")
+	require.EqualError(t, err, "value would lose precision: 500000000 Wei below 1 GWei would be discarded")
+
+	// The truncating variant is unchanged.
+	result, err := string2eth.StringToGWei("1.5 gwei")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result)
+}
+
+func TestStringToWeiRounded(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		mode   string2eth.RoundingMode
+		result *big.Int
+		err    error
+	}{
+		{
+			name:   "Exact",
+			input:  "1.5 ether",
+			mode:   string2eth.RoundUp,
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Down",
+			input:  "2.8765432 megawei",
+			mode:   string2eth.RoundDown,
+			result: big.NewInt(2876543),
+		},
+		{
+			name:   "Up",
+			input:  "2.8765432 megawei",
+			mode:   string2eth.RoundUp,
+			result: big.NewInt(2876544),
+		},
+		{
+			name:   "HalfUpBelowHalf",
+			input:  "2.8765432 megawei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(2876543),
+		},
+		{
+			name:   "HalfUpHalf",
+			input:  "2.5 wei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(3),
+		},
+		{
+			name:   "HalfEvenHalfToZero",
+			input:  "0.5 wei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(0),
+		},
+		{
+			name:   "HalfEvenHalfUp",
+			input:  "1.5 wei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(2),
+		},
+		{
+			name:   "HalfEvenHalfDown",
+			input:  "2.5 wei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(2),
+		},
+		{
+			name:   "HalfEvenAboveHalf",
+			input:  "0.51 wei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(1),
+		},
+		{
+			name:   "UpAcrossUnitBoundary",
+			input:  "0.9999999999999999999 ether",
+			mode:   string2eth.RoundUp,
+			result: _bigInt("1000000000000000000"),
+		},
+		{
+			name:   "HalfUpAcrossUnitBoundary",
+			input:  "999.9995 wei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(1000),
+		},
+		{
+			name:   "DownToZero",
+			input:  "0.9 wei",
+			mode:   string2eth.RoundDown,
+			result: big.NewInt(0),
+		},
+		{
+			name:   "Exponent",
+			input:  "1.5e-1 wei",
+			mode:   string2eth.RoundUp,
+			result: big.NewInt(1),
+		},
+		{
+			name:   "Rational",
+			input:  "1/3 wei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(0),
+		},
+		{
+			name:   "RationalUp",
+			input:  "2/3 wei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(1),
+		},
+		{
+			name:   "VulgarFraction",
+			input:  "1½ wei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(2),
+		},
+		{
+			name:  "Negative",
+			input: "-0.5 wei",
+			mode:  string2eth.RoundUp,
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:  "Invalid",
+			input: "1.5 foo",
+			mode:  string2eth.RoundUp,
+			err:   string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiRounded(test.input, test.mode)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result.Text(10), result.Text(10))
+			}
+		})
+	}
+}
+
+func TestStringToWeiRoundedExact(t *testing.T) {
+	for _, input := range []string{"2.8765432 megawei", "0.5 wei", "1/3 wei", "1½ wei"} {
+		_, err := string2eth.StringToWei(input)
+		require.ErrorIs(t, err, string2eth.ErrFractional, input)
+	}
+}
+
+func TestWeiToStringFixed(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Int
+		unit     string
+		decimals int
+		mode     string2eth.RoundingMode
+		result   string
+	}{
+		{
+			name:     "Nil",
+			unit:     "ether",
+			decimals: 4,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "OneEther",
+			input:    _bigInt("1000000000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			result:   "1.0000 Ether",
+		},
+		{
+			name:     "OneFinney",
+			input:    _bigInt("1000000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			result:   "0.0010 Ether",
+		},
+		{
+			name:     "Large",
+			input:    _bigInt("123456789000000000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			result:   "123456789.0000 Ether",
+		},
+		{
+			name:     "HalfUpHalf",
+			input:    _bigInt("50000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfUp,
+			result:   "0.0001 Ether",
+		},
+		{
+			name:     "HalfEvenHalfToZero",
+			input:    _bigInt("50000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfEven,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "TowardZeroHalf",
+			input:    _bigInt("50000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundTowardZero,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "HalfUpBelowHalf",
+			input:    _bigInt("49999999999999"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfUp,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "HalfEvenAboveHalf",
+			input:    _bigInt("50000000000001"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfEven,
+			result:   "0.0001 Ether",
+		},
+		{
+			name:     "HalfEvenHalfToEvenUp",
+			input:    _bigInt("150000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfEven,
+			result:   "0.0002 Ether",
+		},
+		{
+			name:     "HalfEvenHalfToEvenDown",
+			input:    _bigInt("250000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfEven,
+			result:   "0.0002 Ether",
+		},
+		{
+			name:     "TowardZeroAboveHalf",
+			input:    _bigInt("99999999999999"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundTowardZero,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "HalfUpNegative",
+			input:    _bigInt("-50000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundHalfUp,
+			result:   "-0.0001 Ether",
+		},
+		{
+			name:     "TowardZeroNegative",
+			input:    _bigInt("-50000000000000"),
+			unit:     "ether",
+			decimals: 4,
+			mode:     string2eth.RoundTowardZero,
+			result:   "0.0000 Ether",
+		},
+		{
+			name:     "GWei",
+			input:    _bigInt("21500000000"),
+			unit:     "gwei",
+			decimals: 2,
+			result:   "21.50 GWei",
+		},
+		{
+			name:     "NoDecimals",
+			input:    _bigInt("21500000000"),
+			unit:     "gwei",
+			decimals: 0,
+			mode:     string2eth.RoundHalfEven,
+			result:   "22 GWei",
+		},
+		{
+			name:     "NegativeDecimals",
+			input:    _bigInt("21400000000"),
+			unit:     "gwei",
+			decimals: -1,
+			result:   "21 GWei",
+		},
+		{
+			name:     "Alias",
+			input:    _bigInt("1000000000000000"),
+			unit:     "finney",
+			decimals: 1,
+			result:   "1.0 Milliether",
+		},
+		{
+			name:     "UnknownUnit",
+			input:    _bigInt("1"),
+			unit:     "foo",
+			decimals: 1,
+			result:   "unknown unit",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringFixed(test.input, test.unit, test.decimals, test.mode)
+			require.Equal(t, test.result, result)
+		})
+	}
+}
+
+func TestUnitToMultiplierAliases(t *testing.T) {
+	tests := []struct {
+		unit       string
+		multiplier string
+	}{
+		{unit: "", multiplier: "1"},
+		{unit: "wei", multiplier: "1"},
+		{unit: "atto", multiplier: "1"},
+		{unit: "attoether", multiplier: "1"},
+		{unit: "ada", multiplier: "1000"},
+		{unit: "kwei", multiplier: "1000"},
+		{unit: "kilowei", multiplier: "1000"},
+		{unit: "femto", multiplier: "1000"},
+		{unit: "femtoether", multiplier: "1000"},
+		{unit: "babbage", multiplier: "1000000"},
+		{unit: "lovelace", multiplier: "1000000"},
+		{unit: "mwei", multiplier: "1000000"},
+		{unit: "megawei", multiplier: "1000000"},
+		{unit: "pico", multiplier: "1000000"},
+		{unit: "picoether", multiplier: "1000000"},
+		{unit: "shannon", multiplier: "1000000000"},
+		{unit: "gwei", multiplier: "1000000000"},
+		{unit: "gigawei", multiplier: "1000000000"},
+		{unit: "nano", multiplier: "1000000000"},
+		{unit: "nanoether", multiplier: "1000000000"},
+		{unit: "szabo", multiplier: "1000000000000"},
+		{unit: "szazbo", multiplier: "1000000000000"},
+		{unit: "micro", multiplier: "1000000000000"},
+		{unit: "microether", multiplier: "1000000000000"},
+		{unit: "finney", multiplier: "1000000000000000"},
+		{unit: "milli", multiplier: "1000000000000000"},
+		{unit: "milliether", multiplier: "1000000000000000"},
+		{unit: "eth", multiplier: "1000000000000000000"},
+		{unit: "ether", multiplier: "1000000000000000000"},
+		{unit: "einstein", multiplier: "1000000000000000000000"},
+		{unit: "grand", multiplier: "1000000000000000000000"},
+		{unit: "kilo", multiplier: "1000000000000000000000"},
+		{unit: "kiloether", multiplier: "1000000000000000000000"},
+		{unit: "mega", multiplier: "1000000000000000000000000"},
+		{unit: "megaether", multiplier: "1000000000000000000000000"},
+		{unit: "giga", multiplier: "1000000000000000000000000000"},
+		{unit: "gigaether", multiplier: "1000000000000000000000000000"},
+		{unit: "tera", multiplier: "1000000000000000000000000000000"},
+		{unit: "teraether", multiplier: "1000000000000000000000000000000"},
+		{unit: "peta", multiplier: "1000000000000000000000000000000000"},
+		{unit: "petaether", multiplier: "1000000000000000000000000000000000"},
+		{unit: "exa", multiplier: "1000000000000000000000000000000000000"},
+		{unit: "exaether", multiplier: "1000000000000000000000000000000000000"},
+		{unit: "zetta", multiplier: "1000000000000000000000000000000000000000"},
+		{unit: "zettaether", multiplier: "1000000000000000000000000000000000000000"},
+		{unit: "yotta", multiplier: "1000000000000000000000000000000000000000000"},
+		{unit: "yottaether", multiplier: "1000000000000000000000000000000000000000000"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.unit, func(t *testing.T) {
+			multiplier, err := string2eth.UnitToMultiplier(test.unit)
+			require.NoError(t, err)
+			require.Equal(t, test.multiplier, multiplier.Text(10))
+
+			// Unit names are case-insensitive.
+			multiplier, err = string2eth.UnitToMultiplier(strings.ToUpper(test.unit))
+			require.NoError(t, err)
+			require.Equal(t, test.multiplier, multiplier.Text(10))
+
+			// StringToWei accepts the unit.
+			wei, err := string2eth.StringToWei("1 " + test.unit)
+			require.NoError(t, err)
+			require.Equal(t, test.multiplier, wei.Text(10))
+		})
+	}
+}
+
+func TestWeiToStringCompact(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+	}{
+		{
+			name:   "Nil",
+			result: "0 ETH",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(1),
+			result: "0 ETH",
+		},
+		{
+			name:   "HalfEther",
+			input:  _bigInt("500000000000000000"),
+			result: "0.5 ETH",
+		},
+		{
+			name:   "999Ether",
+			input:  _bigInt("999000000000000000000"),
+			result: "999 ETH",
+		},
+		{
+			name:   "999.995Ether",
+			input:  _bigInt("999995000000000000000"),
+			result: "1k ETH",
+		},
+		{
+			name:   "1000Ether",
+			input:  _bigInt("1000000000000000000000"),
+			result: "1k ETH",
+		},
+		{
+			name:   "1500Ether",
+			input:  _bigInt("1500000000000000000000"),
+			result: "1.5k ETH",
+		},
+		{
+			name:   "1234567Ether",
+			input:  _bigInt("1234567000000000000000000"),
+			result: "1.23M ETH",
+		},
+		{
+			name:   "999999Ether",
+			input:  _bigInt("999999000000000000000000"),
+			result: "1M ETH",
+		},
+		{
+			name:   "999994Ether",
+			input:  _bigInt("999994000000000000000000"),
+			result: "999.99k ETH",
+		},
+		{
+			name:   "2.3MEther",
+			input:  _bigInt("2300000000000000000000000"),
+			result: "2.3M ETH",
+		},
+		{
+			name:   "1BEther",
+			input:  _bigInt("1000000000000000000000000000"),
+			result: "1B ETH",
+		},
+		{
+			name:   "1TEther",
+			input:  _bigInt("1000000000000000000000000000000"),
+			result: "1T ETH",
+		},
+		{
+			name:   "1500TEther",
+			input:  _bigInt("1500000000000000000000000000000000"),
+			result: "1500T ETH",
+		},
+		{
+			name:   "Negative",
+			input:  _bigInt("-1500000000000000000000"),
+			result: "-1.5k ETH",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringCompact(test.input)
+			require.Equal(t, test.result, result)
+		})
+	}
+}
+
+func TestWeiToStringGrouped(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Int
+		standard bool
+		groupSep rune
+		result   string
+	}{
+		{
+			name:     "Nil",
+			groupSep: ',',
+			result:   "0",
+		},
+		{
+			name:     "Short",
+			input:    big.NewInt(123),
+			groupSep: ',',
+			result:   "123 Wei",
+		},
+		{
+			name:     "Comma",
+			input:    _bigInt("1000000000000000000000"),
+			standard: true,
+			groupSep: ',',
+			result:   "1,000 Ether",
+		},
+		{
+			name:     "FractionUntouched",
+			input:    big.NewInt(1234567),
+			groupSep: ',',
+			result:   "1.234567 MWei",
+		},
+		{
+			name:     "Underscore",
+			input:    _bigInt("1234567000000000000000"),
+			standard: true,
+			groupSep: '_',
+			result:   "1_234.567 Ether",
+		},
+		{
+			name:     "Space",
+			input:    _bigInt("1234000000000"),
+			standard: true,
+			groupSep: ' ',
+			result:   "1 234 GWei",
+		},
+		{
+			name:     "NonASCII",
+			input:    _bigInt("12345678000000000000000000"),
+			standard: true,
+			groupSep: '\u00a0',
+			result:   "12\u00a0345\u00a0678 Ether",
+		},
+		{
+			name:     "Overflow",
+			input:    _bigInt("1000000000000000000000000000000000000000000000"),
+			groupSep: ',',
+			result:   "overflow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToStringGrouped(test.input, test.standard, test.groupSep))
+		})
+	}
+}
+
+func TestWeiToStringMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *big.Int
+		maxUnit string
+		result  string
+	}{
+		{
+			name:    "Nil",
+			maxUnit: "ether",
+			result:  "0",
+		},
+		{
+			name:    "Zero",
+			input:   big.NewInt(0),
+			maxUnit: "ether",
+			result:  "0",
+		},
+		{
+			name:    "BelowMax",
+			input:   big.NewInt(1500000000),
+			maxUnit: "ether",
+			result:  "1.5 GWei",
+		},
+		{
+			name:    "AtMax",
+			input:   _bigInt("1500000000000000000"),
+			maxUnit: "ether",
+			result:  "1.5 Ether",
+		},
+		{
+			name:    "AboveMax",
+			input:   _bigInt("1500000000000000000000"),
+			maxUnit: "ether",
+			result:  "1500 Ether",
+		},
+		{
+			name:    "Overflow",
+			input:   _bigInt("1000000000000000000000000000000000"),
+			maxUnit: "ether",
+			result:  "1000000000000000 Ether",
+		},
+		{
+			name:    "OverflowFractional",
+			input:   _bigInt("1000000000000000000000000000000001"),
+			maxUnit: "ether",
+			result:  "1000000000000000.000000000000000001 Ether",
+		},
+		{
+			name:    "OverflowTeraether",
+			input:   _bigInt("1000000000000000000000000000000000"),
+			maxUnit: "teraether",
+			result:  "1000 Teraether",
+		},
+		{
+			name:    "GWei",
+			input:   _bigInt("1000000000000000000"),
+			maxUnit: "gwei",
+			result:  "1000000000 GWei",
+		},
+		{
+			name:    "UnknownUnit",
+			input:   big.NewInt(1),
+			maxUnit: "foo",
+			result:  "unknown unit",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToStringMax(test.input, test.maxUnit))
+		})
+	}
+
+	// Existing behaviour is unchanged.
+	require.Equal(t, "overflow", string2eth.WeiToString(_bigInt("1000000000000000000000000000000000000000000000"), false))
+}
+
+func TestWeiToStringUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		unit   string
+		result string
+		err    string
+	}{
+		{
+			name:   "Nil",
+			unit:   "gwei",
+			result: "0 GWei",
+		},
+		{
+			name:   "EtherAsGWei",
+			input:  _bigInt("1000000000000000000"),
+			unit:   "gwei",
+			result: "1000000000 GWei",
+		},
+		{
+			name:   "WeiAsEther",
+			input:  big.NewInt(1),
+			unit:   "ether",
+			result: "0.000000000000000001 Ether",
+		},
+		{
+			name:   "FractionalGWei",
+			input:  big.NewInt(21500000000),
+			unit:   "GWei",
+			result: "21.5 GWei",
+		},
+		{
+			name:   "Wei",
+			input:  _bigInt("1500000000000000000"),
+			unit:   "wei",
+			result: "1500000000000000000 Wei",
+		},
+		{
+			name:   "Alias",
+			input:  _bigInt("2500000000000000"),
+			unit:   "finney",
+			result: "2.5 Milliether",
+		},
+		{
+			name:   "Teraether",
+			input:  _bigInt("1500000000000000000"),
+			unit:   "teraether",
+			result: "0.0000000000015 Teraether",
+		},
+		{
+			name:  "UnknownUnit",
+			input: big.NewInt(1),
+			unit:  "foo",
+			err:   "unknown unit foo",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.WeiToStringUnit(test.input, test.unit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, string2eth.ErrUnknownUnit)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestUnitToMultiplierSymbols(t *testing.T) {
+	tests := []struct {
+		unit       string
+		multiplier string
+		err        string
+	}{
+		{unit: "kWei", multiplier: "1000"},
+		{unit: "MWei", multiplier: "1000000"},
+		{unit: "GWei", multiplier: "1000000000"},
+		{unit: "uETH", multiplier: "1000000000000"},
+		{unit: "µETH", multiplier: "1000000000000"},
+		{unit: "μETH", multiplier: "1000000000000"},
+		{unit: "mETH", multiplier: "1000000000000000"},
+		{unit: "kETH", multiplier: "1000000000000000000000"},
+		{unit: "Ξ", multiplier: "1000000000000000000"},
+		{unit: "ξ", multiplier: "1000000000000000000"},
+		{unit: "ETH", multiplier: "1000000000000000000"},
+		{unit: "eth", multiplier: "1000000000000000000"},
+		{unit: "METH", err: "unknown unit METH"},
+		{unit: "KETH", err: "unknown unit KETH"},
+		{unit: "UETH", err: "unknown unit UETH"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.unit, func(t *testing.T) {
+			multiplier, err := string2eth.UnitToMultiplier(test.unit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.multiplier, multiplier.Text(10))
+			}
+		})
+	}
+}
+
+func TestUnitToMultiplierMutation(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		multiplier, err := string2eth.UnitToMultiplier("gwei")
+		require.NoError(t, err)
+		require.Equal(t, "1000000000", multiplier.Text(10))
+		multiplier.Div(multiplier, big.NewInt(1000))
+		multiplier.Neg(multiplier)
+	}
+
+	// Conversions must also be unaffected.
+	result, err := string2eth.StringToWei("1.5 gwei")
+	require.NoError(t, err)
+	require.Equal(t, "1500000000", result.Text(10))
+}
+
+func TestSameUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      string
+		b      string
+		result bool
+		err    string
+	}{
+		{
+			name:   "Identical",
+			a:      "gwei",
+			b:      "gwei",
+			result: true,
+		},
+		{
+			name:   "CaseInsensitive",
+			a:      "GWei",
+			b:      "gwei",
+			result: true,
+		},
+		{
+			name:   "GWeiShannon",
+			a:      "gwei",
+			b:      "shannon",
+			result: true,
+		},
+		{
+			name:   "FinneyMilli",
+			a:      "finney",
+			b:      "milliether",
+			result: true,
+		},
+		{
+			name:   "EmptyWei",
+			a:      "",
+			b:      "wei",
+			result: true,
+		},
+		{
+			name:   "Distinct",
+			a:      "gwei",
+			b:      "ether",
+			result: false,
+		},
+		{
+			name:   "DistinctAliases",
+			a:      "babbage",
+			b:      "shannon",
+			result: false,
+		},
+		{
+			name: "UnknownFirst",
+			a:    "foo",
+			b:    "ether",
+			err:  "unknown unit foo",
+		},
+		{
+			name: "UnknownSecond",
+			a:    "ether",
+			b:    "bar",
+			err:  "unknown unit bar",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.SameUnit(test.a, test.b)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestWeiToStringMinimalExact(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+	}{
+		{
+			name:   "Nil",
+			result: "0",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			result: "0",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(1),
+			result: "1 Wei",
+		},
+		{
+			name:   "OneEther",
+			input:  _bigInt("1000000000000000000"),
+			result: "1 Ether",
+		},
+		{
+			name:   "OneThousandWei",
+			input:  big.NewInt(1000),
+			result: "1 KWei",
+		},
+		{
+			name:   "21GWei",
+			input:  big.NewInt(21000000000),
+			result: "21 GWei",
+		},
+		{
+			name:   "HalfEther",
+			input:  _bigInt("500000000000000000"),
+			result: "0.5 Ether",
+		},
+		{
+			name:   "OddWei",
+			input:  big.NewInt(1234567),
+			result: "1234567 Wei",
+		},
+		{
+			name:   "OddWeiLarge",
+			input:  _bigInt("1000000000000000001"),
+			result: "1000000000000000001 Wei",
+		},
+		{
+			name:   "GWeiRemainder",
+			input:  big.NewInt(21000000001),
+			result: "21000000001 Wei",
+		},
+		{
+			name:   "TieBreakToLargerUnit",
+			input:  big.NewInt(1500),
+			result: "1.5 KWei",
+		},
+		{
+			name:   "Teraether",
+			input:  _bigInt("2000000000000000000000000000000"),
+			result: "2 Teraether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringMinimalExact(test.input)
+			require.Equal(t, test.result, result)
+
+			// Ensure that the result round-trips.
+			wei, err := string2eth.StringToWei(result)
+			require.NoError(t, err)
+			if test.input == nil {
+				require.Zero(t, wei.Sign())
+			} else {
+				require.Equal(t, 0, test.input.Cmp(wei))
+			}
+		})
+	}
+}
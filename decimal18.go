@@ -0,0 +1,87 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// decimal18Places is the number of fractional digits in a strict decimal string.
+const decimal18Places = 18
+
+// ParseStrictDecimal18 turns a fixed-point Ether string with exactly 18
+// decimal places, e.g. "1.500000000000000000", in to a number of Wei.
+// Unlike StringToWei this does not accept units, signs, whitespace or
+// grouping characters.
+func ParseStrictDecimal18(input string) (*big.Int, error) {
+	if input == "" {
+		return nil, ErrEmptyValue
+	}
+	if err := checkInputLength(input, DefaultMaxInputLength); err != nil {
+		return nil, err
+	}
+
+	intPart, decPart, found := strings.Cut(input, ".")
+	if !found {
+		return nil, wrapError(ErrInvalidFormat, "missing decimal point")
+	}
+	if intPart == "" || !isDigits(intPart) || !isDigits(decPart) {
+		return nil, wrapError(ErrInvalidFormat, input)
+	}
+	if len(decPart) != decimal18Places {
+		return nil, wrapError(ErrInvalidFormat, "expected "+strconv.Itoa(decimal18Places)+" decimal places, found "+strconv.Itoa(len(decPart)))
+	}
+
+	result, success := new(big.Int).SetString(intPart+decPart, 10)
+	if !success {
+		return nil, wrapErrorValue(ErrParseFailure, input)
+	}
+
+	return result, nil
+}
+
+// FormatStrictDecimal18 turns a number of Wei in to a fixed-point Ether
+// string with exactly 18 decimal places, as accepted by ParseStrictDecimal18.
+// Negative values cannot be represented, and return ErrNegative.
+func FormatStrictDecimal18(wei *big.Int) (string, error) {
+	value := new(big.Int)
+	if wei != nil {
+		if wei.Sign() < 0 {
+			return "", ErrNegative
+		}
+		value.Set(wei)
+	}
+
+	str := value.Text(10)
+	if len(str) <= decimal18Places {
+		str = strings.Repeat("0", decimal18Places+1-len(str)) + str
+	}
+	str = str[:len(str)-decimal18Places] + "." + str[len(str)-decimal18Places:]
+
+	return str, nil
+}
+
+// isDigits returns true if the input consists solely of ASCII digits.
+func isDigits(input string) bool {
+	for i := 0; i < len(input); i++ {
+		if !isDigit(input[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseStrictDecimal18(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:   "Zero",
+			input:  "0.000000000000000000",
+			result: big.NewInt(0),
+		},
+		{
+			name:   "OneAndAHalf",
+			input:  "1.500000000000000000",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:   "OneWei",
+			input:  "0.000000000000000001",
+			result: big.NewInt(1),
+		},
+		{
+			name:   "Large",
+			input:  "123456789.000000000000000001",
+			result: _bigInt("123456789000000000000000001"),
+		},
+		{
+			name:  "NoDecimalPoint",
+			input: "1",
+			err:   "invalid format: missing decimal point",
+		},
+		{
+			name:  "TwoDecimalPoints",
+			input: "1.500000000000000000.0",
+			err:   "invalid format: 1.500000000000000000.0",
+		},
+		{
+			name:  "NoIntegerPart",
+			input: ".500000000000000000",
+			err:   "invalid format: .500000000000000000",
+		},
+		{
+			name:  "17Decimals",
+			input: "1.50000000000000000",
+			err:   "invalid format: expected 18 decimal places, found 17",
+		},
+		{
+			name:  "19Decimals",
+			input: "1.5000000000000000000",
+			err:   "invalid format: expected 18 decimal places, found 19",
+		},
+		{
+			name:  "Grouping",
+			input: "1,000.000000000000000000",
+			err:   "invalid format: 1,000.000000000000000000",
+		},
+		{
+			name:  "Underscore",
+			input: "1_000.000000000000000000",
+			err:   "invalid format: 1_000.000000000000000000",
+		},
+		{
+			name:  "Negative",
+			input: "-1.000000000000000000",
+			err:   "invalid format: -1.000000000000000000",
+		},
+		{
+			name:  "Positive",
+			input: "+1.000000000000000000",
+			err:   "invalid format: +1.000000000000000000",
+		},
+		{
+			name:  "Unit",
+			input: "1.000000000000000000 ether",
+			err:   "invalid format: 1.000000000000000000 ether",
+		},
+		{
+			name:  "UnitNoSpace",
+			input: "1.000000000000000000ETH",
+			err:   "invalid format: 1.000000000000000000ETH",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.ParseStrictDecimal18(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+				formatted, err := string2eth.FormatStrictDecimal18(result)
+				require.NoError(t, err)
+				require.Equal(t, test.input, formatted)
+			}
+		})
+	}
+}
+
+func TestFormatStrictDecimal18(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+		err    error
+	}{
+		{
+			name:   "Nil",
+			result: "0.000000000000000000",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			result: "0.000000000000000000",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(1),
+			result: "0.000000000000000001",
+		},
+		{
+			name:   "OneEther",
+			input:  big.NewInt(1000000000000000000),
+			result: "1.000000000000000000",
+		},
+		{
+			name:   "Large",
+			input:  _bigInt("1234000000000000000000000"),
+			result: "1234000.000000000000000000",
+		},
+		{
+			name:  "Negative",
+			input: _bigInt("-1500000000000000000"),
+			err:   string2eth.ErrNegative,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.FormatStrictDecimal18(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
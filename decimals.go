@@ -0,0 +1,67 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// StringToWeiMaxDecimals turns a string in to number of Wei, returning
+// ErrTooManyDecimals if the number has more than the given number of decimal
+// places in the unit supplied, e.g. "0.123456789 gwei" has 9 decimal places.
+// Trailing zeros are not counted, and a negative maximum is treated as 0.
+// The number of decimal places is checked before the value is converted, so
+// "1.0000000000000000001 ether" with a maximum of 18 returns
+// ErrTooManyDecimals rather than ErrFractional.
+// See StringToWei for details.
+func StringToWeiMaxDecimals(input string, maxDecimals int) (*big.Int, error) {
+	if maxDecimals < 0 {
+		maxDecimals = 0
+	}
+	opts := newParseOptions(DefaultUnitResolver)
+	opts.limitDecimals = true
+	opts.maxDecimals = maxDecimals
+	result, _, err := stringToWei(input, opts)
+
+	return result, err
+}
+
+// checkDecimals returns ErrTooManyDecimals if the options limit the number of
+// decimal places and the limit is exceeded.
+func checkDecimals(decimals int, opts *parseOptions) error {
+	if !opts.limitDecimals || decimals <= opts.maxDecimals {
+		return nil
+	}
+
+	return wrapError(ErrTooManyDecimals, strconv.Itoa(decimals)+" exceeds maximum of "+strconv.Itoa(opts.maxDecimals))
+}
+
+// checkRationalDecimals returns ErrTooManyDecimals if the options limit the
+// number of decimal places and the numerator divided by the denominator
+// cannot be written within the limit, e.g. "1/3" at any maximum or "1/8" with
+// a maximum below 3.
+func checkRationalDecimals(numerator *big.Int, denominator *big.Int, opts *parseOptions) error {
+	if !opts.limitDecimals {
+		return nil
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(opts.maxDecimals)), nil)
+	if new(big.Int).Rem(new(big.Int).Mul(numerator, scale), denominator).Sign() != 0 {
+		return wrapError(ErrTooManyDecimals, "exceeds maximum of "+strconv.Itoa(opts.maxDecimals))
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringToWeiMaxDecimals(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		maxDecimals int
+		result      *big.Int
+		err         error
+	}{
+		{
+			name:        "WithinMaximum",
+			input:       "0.123456789 gwei",
+			maxDecimals: 9,
+			result:      big.NewInt(123456789),
+		},
+		{
+			name:        "ExceedsMaximum",
+			input:       "0.123456789 gwei",
+			maxDecimals: 3,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "TrailingZeros",
+			input:       "1.2000000 ether",
+			maxDecimals: 1,
+			result:      big.NewInt(1200000000000000000),
+		},
+		{
+			name:        "Integer",
+			input:       "21 gwei",
+			maxDecimals: 0,
+			result:      big.NewInt(21000000000),
+		},
+		{
+			name:        "NegativeMaximum",
+			input:       "1.5 gwei",
+			maxDecimals: -1,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "FractionalWei",
+			input:       "1.0000000000000000001 ether",
+			maxDecimals: 18,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "FractionalWeiWithinMaximum",
+			input:       "1.0000000000000000001 ether",
+			maxDecimals: 19,
+			err:         string2eth.ErrFractional,
+		},
+		{
+			name:        "Exponent",
+			input:       "1.5e-3 gwei",
+			maxDecimals: 3,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "ExponentWithinMaximum",
+			input:       "1.5e-3 gwei",
+			maxDecimals: 4,
+			result:      big.NewInt(1500000),
+		},
+		{
+			name:        "Rational",
+			input:       "1/8 ether",
+			maxDecimals: 3,
+			result:      big.NewInt(125000000000000000),
+		},
+		{
+			name:        "RationalExceedsMaximum",
+			input:       "1/8 ether",
+			maxDecimals: 2,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "RationalRecurring",
+			input:       "1/3 ether",
+			maxDecimals: 18,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "VulgarFraction",
+			input:       "1½ gwei",
+			maxDecimals: 1,
+			result:      big.NewInt(1500000000),
+		},
+		{
+			name:        "Compound",
+			input:       "1 ether 0.25 finney",
+			maxDecimals: 1,
+			err:         string2eth.ErrTooManyDecimals,
+		},
+		{
+			name:        "Invalid",
+			input:       "1.5.1 ether",
+			maxDecimals: 9,
+			err:         string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiMaxDecimals(test.input, test.maxDecimals)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"strings"
+	"unicode"
+)
+
+// normaliseDigits maps Unicode decimal digits, e.g. full-width "１" or
+// Arabic-Indic "١", to their ASCII equivalents, along with the full-width
+// period and comma.
+func normaliseDigits(input string) string {
+	if isASCII(input) {
+		return input
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '．':
+			return '.'
+		case r == '，':
+			return ','
+		case r > unicode.MaxASCII && unicode.Is(unicode.Nd, r):
+			return '0' + digitValue(r)
+		default:
+			return r
+		}
+	}, input)
+}
+
+// digitValue returns the value of a Unicode decimal digit.
+// Decimal digits are encoded in contiguous runs starting at zero, so the value
+// is the offset from the start of the run.
+func digitValue(r rune) rune {
+	for _, rng := range unicode.Nd.R16 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return (r - rune(rng.Lo)) % 10
+		}
+	}
+	for _, rng := range unicode.Nd.R32 {
+		if r >= rune(rng.Lo) && r <= rune(rng.Hi) {
+			return (r - rune(rng.Lo)) % 10
+		}
+	}
+
+	return 0
+}
+
+// isASCII returns true if the input contains only ASCII characters.
+func isASCII(input string) bool {
+	for i := 0; i < len(input); i++ {
+		if input[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestUnicodeDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:   "FullWidth",
+			input:  "１．５ ether",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:   "FullWidthComma",
+			input:  "１，０００ wei",
+			result: big.NewInt(1000),
+		},
+		{
+			name:   "ArabicIndic",
+			input:  "٢١ gwei",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "ExtendedArabicIndic",
+			input:  "۱۲۳",
+			result: big.NewInt(123),
+		},
+		{
+			name:   "Devanagari",
+			input:  "३.१४ ether",
+			result: big.NewInt(3140000000000000000),
+		},
+		{
+			name:   "MixedScript",
+			input:  "1２٣३ wei",
+			result: big.NewInt(1233),
+		},
+		{
+			name:   "FullWidthHex",
+			input:  "0x１０",
+			result: big.NewInt(16),
+		},
+		{
+			name:  "NonDecimalNumber",
+			input: "Ⅷ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWei(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
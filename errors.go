@@ -0,0 +1,344 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseError provides details of a failure to parse an input string.
+// The underlying error is available with errors.Is, e.g.
+// errors.Is(err, ErrParseFailure).
+type ParseError struct {
+	// Input is the input that failed to parse, as supplied.
+	Input string
+	// Offset is the byte offset in the input of the token, or -1 if it is not
+	// known.
+	Offset int
+	// Token is the part of the input that caused the failure, e.g. an unknown
+	// unit or an offending character, if known.
+	Token string
+	// Amount is the number that failed to parse, if known.
+	Amount string
+	// Unit is the unit that failed to parse, if known.
+	Unit string
+	// Runes are the offending runes in the input, if known.
+	Runes []rune
+	// Guidance is a suggestion to the user on how to correct the input.
+	Guidance string
+	// Err is the underlying error.
+	Err error
+	// Cause is the error that led to the failure, if known, for example an
+	// *UnknownUnitError.
+	Cause error
+}
+
+// Error implements the error interface.
+// If the input is known it is quoted verbatim along with the position of the
+// token, e.g. `failed to parse "1 foo" at offset 2 ("foo")`; otherwise the
+// amount and unit are used, e.g. "failed to parse 1 foo".
+func (e *ParseError) Error() string {
+	msg := e.Err.Error()
+	if e.Input != "" {
+		msg += " " + strconv.Quote(e.Input)
+		if e.Token != "" && e.Offset >= 0 {
+			msg += " at offset " + strconv.Itoa(e.Offset) + " (" + strconv.Quote(e.Token) + ")"
+		}
+	} else {
+		for _, part := range []string{e.Amount, e.Unit} {
+			if part != "" {
+				msg += " " + part
+			}
+		}
+	}
+	if e.Guidance != "" {
+		msg += ": " + e.Guidance
+	}
+
+	return msg
+}
+
+// locate sets the input of the error, along with the token and its offset in
+// the input.
+func (e *ParseError) locate(input string) {
+	e.Input = input
+	if e.Token == "" {
+		switch {
+		case len(e.Runes) > 0:
+			e.Token = string(e.Runes[0])
+		case e.Unit != "":
+			e.Token = e.Unit
+		default:
+			e.Token = e.Amount
+		}
+	}
+
+	e.Offset = -1
+	switch {
+	case e.Token == "":
+	case e.Token == e.Amount:
+		e.Offset = strings.Index(input, e.Token)
+	default:
+		// Units and offending characters follow the number, so are found
+		// from the end of the input.
+		e.Offset = strings.LastIndex(input, e.Token)
+	}
+}
+
+// Unwrap returns the underlying error and, if present, the cause.
+func (e *ParseError) Unwrap() []error {
+	if e.Cause == nil {
+		return []error{e.Err}
+	}
+
+	return []error{e.Err, e.Cause}
+}
+
+// unitParseError returns a parse error for an amount whose unit could not be
+// resolved, suggesting the intended unit if it appears to be misspelt.
+func unitParseError(amount string, unit string, err error) *ParseError {
+	parseErr := &ParseError{Amount: amount, Unit: unit, Err: ErrParseFailure, Cause: err}
+
+	// The error may itself be a parse error from parsing part of the amount.
+	var innerErr *ParseError
+	if errors.As(err, &innerErr) {
+		parseErr.Cause = innerErr.Cause
+	}
+
+	var unknownErr *UnknownUnitError
+	if errors.As(parseErr.Cause, &unknownErr) && unknownErr.Suggestion() != "" {
+		parseErr.Guidance = "did you mean " + strconv.Quote(unknownErr.Suggestion()) + "?"
+	}
+
+	return parseErr
+}
+
+// UnknownUnitError is returned when a unit is not known.
+// The underlying error is ErrUnknownUnit.
+type UnknownUnitError struct {
+	// Unit is the unit as supplied.
+	Unit       string
+	suggestion string
+}
+
+// newUnknownUnitError returns an error for the unknown unit, with a
+// suggestion of the intended unit if there is one.
+func newUnknownUnitError(unit string) *UnknownUnitError {
+	return &UnknownUnitError{
+		Unit:       unit,
+		suggestion: suggestUnit(unit),
+	}
+}
+
+// Error implements the error interface.
+func (e *UnknownUnitError) Error() string {
+	msg := ErrUnknownUnit.Error() + " " + e.Unit
+	if e.suggestion != "" {
+		msg += " (did you mean " + strconv.Quote(e.suggestion) + "?)"
+	}
+
+	return msg
+}
+
+// Unwrap returns ErrUnknownUnit.
+func (e *UnknownUnitError) Unwrap() error {
+	return ErrUnknownUnit
+}
+
+// Suggestion returns the name of the unit that was probably intended, e.g.
+// "gwei" for "gewi", or an empty string if there is no close match.
+func (e *UnknownUnitError) Suggestion() string {
+	return e.suggestion
+}
+
+// maxSuggestionDistance is the maximum edit distance between an unknown unit
+// and a known unit for the latter to be suggested.
+const maxSuggestionDistance = 2
+
+// suggestUnit returns the known unit name closest to the given unit, or an
+// empty string if no name is close enough.  To avoid nonsense suggestions the
+// distance must also be less than half the length of the unit.
+func suggestUnit(unit string) string {
+	lowerUnit := strings.ToLower(unit)
+	if lowerUnit == "" {
+		return ""
+	}
+	for symbol := range siSymbols {
+		if strings.EqualFold(unit, symbol) {
+			// A wrongly-cased symbol, e.g. "METH", is deliberately rejected
+			// as ambiguous so there is no suggestion.
+			return ""
+		}
+	}
+
+	suggestion := ""
+	bestDistance := maxSuggestionDistance + 1
+	for name := range unitNames {
+		// Very short names would be suggested for almost any short input.
+		if utf8.RuneCountInString(name) < 3 {
+			continue
+		}
+		distance := editDistance(lowerUnit, name)
+		// Ties are broken alphabetically so that the suggestion is stable.
+		if distance < bestDistance || (distance == bestDistance && name < suggestion) {
+			suggestion = name
+			bestDistance = distance
+		}
+	}
+
+	if bestDistance*2 >= utf8.RuneCountInString(lowerUnit) {
+		return ""
+	}
+
+	return suggestion
+}
+
+// editDistance returns the optimal string alignment distance between two
+// strings, i.e. the number of insertions, deletions, substitutions and
+// transpositions of adjacent runes to turn one in to the other.
+func editDistance(a string, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	// distances[i][j] is the distance between ra[:i] and rb[:j].
+	distances := make([][]int, len(ra)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(rb)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			distance := distances[i-1][j-1] + cost
+			if deletion := distances[i-1][j] + 1; deletion < distance {
+				distance = deletion
+			}
+			if insertion := distances[i][j-1] + 1; insertion < distance {
+				distance = insertion
+			}
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				if transposition := distances[i-2][j-2] + 1; transposition < distance {
+					distance = transposition
+				}
+			}
+			distances[i][j] = distance
+		}
+	}
+
+	return distances[len(ra)][len(rb)]
+}
+
+// checkUnitRunes returns a parse error if the unit part of the input contains
+// non-ASCII letters or digits, for example superscripts in OCR'd text.
+func checkUnitRunes(input string) error {
+	unit := strings.TrimLeft(input, "-0123456789.")
+
+	offending := make([]rune, 0)
+	cleaned := make([]rune, 0, len(unit))
+	for _, r := range unit {
+		if r > unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsNumber(r)) {
+			offending = append(offending, r)
+		} else {
+			cleaned = append(cleaned, r)
+		}
+	}
+	if len(offending) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, len(offending))
+	allNumbers := true
+	for i, r := range offending {
+		quoted[i] = strconv.QuoteRune(r)
+		allNumbers = allNumbers && unicode.IsNumber(r)
+	}
+	guidance := "unit " + strconv.Quote(unit) + " contains non-ASCII characters " + strings.Join(quoted, ", ") + "; use ASCII unit names"
+	// Superscripts and subscripts are decoration, so suggest the unit without them.
+	if allNumbers && len(cleaned) > 0 {
+		if _, err := multiplierFor(string(cleaned)); err == nil {
+			guidance += " such as " + strconv.Quote(string(cleaned))
+		}
+	}
+
+	return &ParseError{
+		Input:    input,
+		Runes:    offending,
+		Guidance: guidance,
+		Err:      ErrInvalidFormat,
+	}
+}
+
+// UnsupportedTypeError is returned when a value of an unsupported Go type is
+// supplied.
+type UnsupportedTypeError struct {
+	// Type is the name of the Go type, e.g. "int32".
+	Type string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedTypeError) Error() string {
+	return ErrParseFailure.Error() + ": unsupported type " + e.Type
+}
+
+// Unwrap returns ErrParseFailure.
+func (e *UnsupportedTypeError) Unwrap() error {
+	return ErrParseFailure
+}
+
+// wrappedError is an error with additional detail that wraps an underlying
+// error, so that the underlying error can be found with errors.Is.
+type wrappedError struct {
+	msg string
+	err error
+}
+
+// Error implements the error interface.
+func (e *wrappedError) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the underlying error.
+func (e *wrappedError) Unwrap() error {
+	return e.err
+}
+
+// wrapError returns an error that wraps err, with the detail following the
+// message of err after a colon, e.g. "invalid format: unit is required".
+func wrapError(err error, detail string) error {
+	return &wrappedError{
+		msg: err.Error() + ": " + detail,
+		err: err,
+	}
+}
+
+// wrapErrorValue returns an error that wraps err, with the value following
+// the message of err after a space, e.g. "unknown unit foo".
+func wrapErrorValue(err error, value string) error {
+	return &wrappedError{
+		msg: err.Error() + " " + value,
+		err: err,
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseErrorNonASCIIUnit(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		runes []rune
+		err   string
+	}{
+		{
+			name:  "Superscript",
+			input: "1 gwei²",
+			runes: []rune{'²'},
+			err:   `invalid format "1 gwei²" at offset 6 ("²"): unit "gwei²" contains non-ASCII characters '²'; use ASCII unit names such as "gwei"`,
+		},
+		{
+			name:  "Subscript",
+			input: "2.5 ₁ether",
+			runes: []rune{'₁'},
+			err:   `invalid format "2.5 ₁ether" at offset 4 ("₁"): unit "₁ether" contains non-ASCII characters '₁'; use ASCII unit names such as "ether"`,
+		},
+		{
+			name:  "Multiple",
+			input: "3 wei³⁴",
+			runes: []rune{'³', '⁴'},
+			err:   `invalid format "3 wei³⁴" at offset 5 ("³"): unit "wei³⁴" contains non-ASCII characters '³', '⁴'; use ASCII unit names such as "wei"`,
+		},
+		{
+			name:  "UnknownUnit",
+			input: "3 foo²",
+			runes: []rune{'²'},
+			err:   `invalid format "3 foo²" at offset 5 ("²"): unit "foo²" contains non-ASCII characters '²'; use ASCII unit names`,
+		},
+		{
+			name:  "Letter",
+			input: "1 µether",
+			runes: []rune{'µ'},
+			err:   `invalid format "1 µether" at offset 2 ("µ"): unit "µether" contains non-ASCII characters 'µ'; use ASCII unit names`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := string2eth.StringToWei(test.input)
+			require.EqualError(t, err, test.err)
+			require.ErrorIs(t, err, string2eth.ErrInvalidFormat)
+			var parseErr *string2eth.ParseError
+			require.True(t, errors.As(err, &parseErr))
+			require.Equal(t, test.runes, parseErr.Runes)
+		})
+	}
+}
+
+func TestParseErrorParseFailure(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		amount string
+		unit   string
+		err    string
+	}{
+		{
+			name:   "Integer",
+			input:  "  1000   foo ",
+			amount: "1000",
+			unit:   "foo",
+			err:    `failed to parse "  1000   foo " at offset 9 ("foo")`,
+		},
+		{
+			name:   "Decimal",
+			input:  "1000.5\tfoo",
+			amount: "1000.5",
+			unit:   "foo",
+			err:    `failed to parse "1000.5\tfoo" at offset 7 ("foo")`,
+		},
+		{
+			name:  "Rational",
+			input: "1/4 foo",
+			unit:  "foo",
+			err:   `failed to parse "1/4 foo" at offset 4 ("foo")`,
+		},
+		{
+			name:   "Compound",
+			input:  "1 ether 500 foo",
+			amount: "500",
+			unit:   "foo",
+			err:    `failed to parse "1 ether 500 foo" at offset 12 ("foo")`,
+		},
+		{
+			name:   "Parenthesised",
+			input:  "(1 foo)",
+			amount: "1",
+			unit:   "foo",
+			err:    `failed to parse "(1 foo)" at offset 3 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := string2eth.StringToWeiSigned(test.input)
+			require.EqualError(t, err, test.err)
+			require.ErrorIs(t, err, string2eth.ErrParseFailure)
+			var parseErr *string2eth.ParseError
+			require.True(t, errors.As(err, &parseErr))
+			require.Equal(t, test.input, parseErr.Input)
+			require.Equal(t, test.amount, parseErr.Amount)
+			require.Equal(t, test.unit, parseErr.Unit)
+		})
+	}
+}
+
+func TestParseErrorLocation(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+		token  string
+		err    error
+	}{
+		{
+			name:   "UnknownUnit",
+			input:  "1.5 foo",
+			offset: 4,
+			token:  "foo",
+			err:    string2eth.ErrParseFailure,
+		},
+		{
+			name:   "NonASCIIUnit",
+			input:  "3 wei³⁴",
+			offset: 5,
+			token:  "³",
+			err:    string2eth.ErrInvalidFormat,
+		},
+		{
+			name:   "Whitespace",
+			input:  "\t 2   gewi",
+			offset: 6,
+			token:  "gewi",
+			err:    string2eth.ErrParseFailure,
+		},
+		{
+			name:   "TokenNotInInput",
+			input:  "10 wei wei wei",
+			offset: -1,
+			token:  "weiweiwei",
+			err:    string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := string2eth.StringToWei(test.input)
+			require.ErrorIs(t, err, test.err)
+			var parseErr *string2eth.ParseError
+			require.True(t, errors.As(err, &parseErr))
+			require.Equal(t, test.input, parseErr.Input)
+			require.Equal(t, test.offset, parseErr.Offset)
+			require.Equal(t, test.token, parseErr.Token)
+			if test.offset >= 0 {
+				require.Equal(t, test.token, test.input[test.offset:test.offset+len(test.token)])
+			}
+		})
+	}
+}
+
+func TestParseErrorWithoutInput(t *testing.T) {
+	err := &string2eth.ParseError{
+		Amount: "1",
+		Unit:   "foo",
+		Err:    string2eth.ErrParseFailure,
+	}
+	require.EqualError(t, err, "failed to parse 1 foo")
+}
+
+func TestUnknownUnitSuggestion(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		err        string
+		suggestion string
+	}{
+		{
+			name:       "Transposition",
+			input:      "5 gewi",
+			err:        `failed to parse "5 gewi" at offset 2 ("gewi"): did you mean "gwei"?`,
+			suggestion: "gwei",
+		},
+		{
+			name:       "Insertion",
+			input:      "1 etther",
+			err:        `failed to parse "1 etther" at offset 2 ("etther"): did you mean "ether"?`,
+			suggestion: "ether",
+		},
+		{
+			name:       "Deletion",
+			input:      "1.5 finny",
+			err:        `failed to parse "1.5 finny" at offset 4 ("finny"): did you mean "finney"?`,
+			suggestion: "finney",
+		},
+		{
+			name:       "Case",
+			input:      "2 SHANON",
+			err:        `failed to parse "2 SHANON" at offset 2 ("SHANON"): did you mean "shannon"?`,
+			suggestion: "shannon",
+		},
+		{
+			name:       "TwoEdits",
+			input:      "3 szbao",
+			err:        `failed to parse "3 szbao" at offset 2 ("szbao"): did you mean "szabo"?`,
+			suggestion: "szabo",
+		},
+		{
+			name:  "TooDistant",
+			input: "5 foo",
+			err:   `failed to parse "5 foo" at offset 2 ("foo")`,
+		},
+		{
+			name:  "TooDistantShort",
+			input: "5 kfoo",
+			err:   `failed to parse "5 kfoo" at offset 2 ("kfoo")`,
+		},
+		{
+			name:  "AmbiguousSymbol",
+			input: "5 METH",
+			err:   `failed to parse "5 METH" at offset 2 ("METH")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := string2eth.StringToWei(test.input)
+			require.EqualError(t, err, test.err)
+			require.ErrorIs(t, err, string2eth.ErrParseFailure)
+			require.ErrorIs(t, err, string2eth.ErrUnknownUnit)
+
+			var unknownErr *string2eth.UnknownUnitError
+			require.ErrorAs(t, err, &unknownErr)
+			require.Equal(t, test.suggestion, unknownErr.Suggestion())
+		})
+	}
+}
+
+func TestUnitToMultiplierSuggestion(t *testing.T) {
+	_, err := string2eth.UnitToMultiplier("gewi")
+	require.EqualError(t, err, `unknown unit gewi (did you mean "gwei"?)`)
+	require.ErrorIs(t, err, string2eth.ErrUnknownUnit)
+
+	var suggester interface{ Suggestion() string }
+	require.True(t, errors.As(err, &suggester))
+	require.Equal(t, "gwei", suggester.Suggestion())
+
+	_, err = string2eth.UnitToMultiplier("bananas")
+	require.EqualError(t, err, "unknown unit bananas")
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// EtherToWei turns a string of Ether in to number of Wei, e.g. "1.5" is
+// 1.5*10^18 Wei.  Unlike StringToWei a number without a unit is Ether rather
+// than Wei.  The ether unit may be supplied, e.g. "1.5 ETH", but any other
+// unit results in an error.
+// See StringToWei for details.
+func EtherToWei(ether string) (*big.Int, error) {
+	resolver := UnitResolverFunc(func(unit string) (*big.Int, error) {
+		if unit == "" {
+			unit = "ether"
+		}
+		if unitPos, exists := lookupUnitPos(unit); !exists || unitPos != etherPos {
+			return nil, wrapErrorValue(ErrUnknownUnit, unit)
+		}
+
+		return new(big.Int).Set(metricMultipliers[etherPos]), nil
+	})
+	result, _, err := stringToWei(ether, newParseOptions(resolver))
+
+	return result, err
+}
+
+// WeiToEtherString turns a number of Wei in to a number of Ether, without a
+// unit, e.g. 1 Wei is "0.000000000000000001".
+// Trailing zeros are removed.
+func WeiToEtherString(wei *big.Int) string {
+	return strings.TrimSuffix(weiToUnitString(wei, etherPos), " "+metricUnits[etherPos])
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestEtherToWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:   "Bare",
+			input:  "1.5",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Integer",
+			input:  "2",
+			result: _bigInt("2000000000000000000"),
+		},
+		{
+			name:   "Smallest",
+			input:  "0.000000000000000001",
+			result: big.NewInt(1),
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "ETH",
+			input:  "1.5 ETH",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Symbol",
+			input:  "Ξ1.5",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:  "OtherUnit",
+			input: "1.5 gwei",
+			err:   string2eth.ErrParseFailure,
+		},
+		{
+			name:  "Fractional",
+			input: "0.0000000000000000001",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "Negative",
+			input: "-1",
+			err:   string2eth.ErrNegative,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.EtherToWei(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestWeiToEtherString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result string
+	}{
+		{
+			name:   "Nil",
+			result: "0",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			result: "0",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(1),
+			result: "0.000000000000000001",
+		},
+		{
+			name:   "OneEther",
+			input:  _bigInt("1000000000000000000"),
+			result: "1",
+		},
+		{
+			name:   "Fractional",
+			input:  _bigInt("1500000000000000000"),
+			result: "1.5",
+		},
+		{
+			name:   "Large",
+			input:  _bigInt("1234567000000000000000000"),
+			result: "1234567",
+		},
+		{
+			name:   "Negative",
+			input:  _bigInt("-1500000000000000000"),
+			result: "-1.5",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToEtherString(test.input))
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FoundValue is a value found in text by FindValues.
+type FoundValue struct {
+	// Text is the text of the value, e.g. "0.05 eth".
+	Text string
+	// Start is the byte offset of the start of the value in the text.
+	Start int
+	// End is the byte offset of the end of the value in the text.
+	End int
+	// Wei is the number of Wei.
+	Wei *big.Int
+	// Unit is the canonical name of the unit, e.g. "Ether".
+	Unit string
+}
+
+// FindValues finds values in free text, e.g. "send 0.05 eth to alice and tip
+// 500 gwei" contains "0.05 eth" and "500 gwei".
+// A value is a number followed, optionally after whitespace, by a unit, e.g.
+// "500 gwei" or "1.5ETH", or the symbol "Ξ" before or after the number, e.g.
+// "Ξ1.5".  Numbers without a unit are not values.
+// The whole word following the number is used as the unit, so "5 ethers" is
+// not a value.  Candidates that do not parse, for example because they result
+// in a fractional number of Wei, are skipped.
+func FindValues(text string) []FoundValue {
+	values := make([]FoundValue, 0)
+	pos := 0
+	for pos < len(text) {
+		value, next, found := findValueAt(text, pos)
+		if found {
+			values = append(values, value)
+		}
+		pos = next
+	}
+
+	return values
+}
+
+// findValueAt attempts to find a value starting at the given position in the
+// text.  It returns the value if found, and the position from which to
+// continue searching.
+func findValueAt(text string, pos int) (FoundValue, int, bool) {
+	if !isDigit(text[pos]) || (!isBoundaryBefore(text, pos) && !hasEtherSymbolBefore(text, pos)) {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+
+		return FoundValue{}, pos + size, false
+	}
+
+	start := pos
+	numberEnd := scanNumber(text, pos)
+
+	unitStart := numberEnd
+	for unitStart < len(text) {
+		r, size := utf8.DecodeRuneInString(text[unitStart:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		unitStart += size
+	}
+	unitEnd := unitStart
+	for unitEnd < len(text) && isLetter(text[unitEnd]) {
+		unitEnd++
+	}
+	if unitEnd == unitStart {
+		if symbol, isSymbol := etherSymbolAt(text, unitStart); isSymbol {
+			unitEnd = unitStart + len(symbol)
+		}
+	}
+
+	// The unit must be known, which also excludes words such as the "nd" in
+	// "2nd".
+	var unitPos int
+	var exists bool
+	if unitEnd > unitStart && isBoundaryAfter(text, unitEnd) {
+		unitPos, exists = lookupUnitPos(text[unitStart:unitEnd])
+	}
+	end := unitEnd
+	if !exists {
+		if start == 0 || !hasEtherSymbolBefore(text, start) {
+			return FoundValue{}, unitEnd, false
+		}
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+		unitStart, unitEnd = start, start+size
+		unitPos = etherPos
+		end = numberEnd
+	}
+
+	unit := text[unitStart:unitEnd]
+	wei, _, err := stringToWei(text[pos:numberEnd]+unit, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return FoundValue{}, unitEnd, false
+	}
+
+	return FoundValue{
+		Text:  text[start:end],
+		Start: start,
+		End:   end,
+		Wei:   wei,
+		Unit:  metricUnits[unitPos],
+	}, end, true
+}
+
+// scanNumber returns the end of the number starting at the given position,
+// which is digits with optional comma grouping and an optional decimal part.
+func scanNumber(text string, pos int) int {
+	for pos < len(text) {
+		switch {
+		case isDigit(text[pos]):
+			pos++
+		case text[pos] == ',' && isGroup(text[pos+1:]):
+			pos += 4
+		default:
+			if text[pos] == '.' && pos+1 < len(text) && isDigit(text[pos+1]) {
+				pos++
+				for pos < len(text) && isDigit(text[pos]) {
+					pos++
+				}
+			}
+
+			return pos
+		}
+	}
+
+	return pos
+}
+
+// isGroup returns true if the text starts with exactly three digits.
+func isGroup(text string) bool {
+	return len(text) >= 3 && isDigit(text[0]) && isDigit(text[1]) && isDigit(text[2]) &&
+		(len(text) == 3 || !isDigit(text[3]))
+}
+
+// isBoundaryBefore returns true if the given position is not preceded by part
+// of a word or number.
+func isBoundaryBefore(text string, pos int) bool {
+	if pos == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(text[:pos])
+
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '.' && r != ',' && r != '-' && r != '_'
+}
+
+// isBoundaryAfter returns true if the given position is not followed by part
+// of a word.
+func isBoundaryAfter(text string, pos int) bool {
+	if pos == len(text) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text[pos:])
+
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+}
+
+// etherSymbolAt returns the ether symbol at the given position in the text.
+// The final return value is false if there is no symbol at the position.
+func etherSymbolAt(text string, pos int) (string, bool) {
+	for _, symbol := range etherSymbols {
+		if strings.HasPrefix(text[pos:], symbol) {
+			return symbol, true
+		}
+	}
+
+	return "", false
+}
+
+// hasEtherSymbolBefore returns true if the given position is directly
+// preceded by an ether symbol, which is itself not part of a word.
+func hasEtherSymbolBefore(text string, pos int) bool {
+	for _, symbol := range etherSymbols {
+		if strings.HasSuffix(text[:pos], symbol) {
+			return isBoundaryBefore(text, pos-len(symbol))
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestFindValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		values []string
+		wei    []string
+		units  []string
+	}{
+		{
+			name: "Empty",
+			text: "",
+		},
+		{
+			name: "NoValues",
+			text: "meet at 5 and bring 2 friends",
+		},
+		{
+			name:   "Sentence",
+			text:   "send 0.05 eth to alice and tip 500 gwei",
+			values: []string{"0.05 eth", "500 gwei"},
+			wei:    []string{"50000000000000000", "500000000000"},
+			units:  []string{"Ether", "GWei"},
+		},
+		{
+			name:   "NoSpace",
+			text:   "pay 1.5ETH now",
+			values: []string{"1.5ETH"},
+			wei:    []string{"1500000000000000000"},
+			units:  []string{"Ether"},
+		},
+		{
+			name:   "Alias",
+			text:   "costs 3 finney.",
+			values: []string{"3 finney"},
+			wei:    []string{"3000000000000000"},
+			units:  []string{"Milliether"},
+		},
+		{
+			name:   "Grouped",
+			text:   "a whale moved 1,000,000 ether, apparently",
+			values: []string{"1,000,000 ether"},
+			wei:    []string{"1000000000000000000000000"},
+			units:  []string{"Ether"},
+		},
+		{
+			name:   "SymbolBefore",
+			text:   "price: Ξ0.5 each",
+			values: []string{"Ξ0.5"},
+			wei:    []string{"500000000000000000"},
+			units:  []string{"Ether"},
+		},
+		{
+			name:   "SymbolAfter",
+			text:   "price: 0.5 Ξ each",
+			values: []string{"0.5 Ξ"},
+			wei:    []string{"500000000000000000"},
+			units:  []string{"Ether"},
+		},
+		{
+			name:   "LongestUnit",
+			text:   "10 ethers or 10 ether",
+			values: []string{"10 ether"},
+			wei:    []string{"10000000000000000000"},
+			units:  []string{"Ether"},
+		},
+		{
+			name: "PartOfWord",
+			text: "the 2nd of 0x10 gwei, v1.5 eth",
+		},
+		{
+			name: "Fractional",
+			text: "0.5 wei is not possible",
+		},
+		{
+			name:   "NewLine",
+			text:   "tip\n21\ngwei",
+			values: []string{"21\ngwei"},
+			wei:    []string{"21000000000"},
+			units:  []string{"GWei"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			found := string2eth.FindValues(test.text)
+			require.Len(t, found, len(test.values))
+			for i := range found {
+				require.Equal(t, test.values[i], found[i].Text)
+				require.Equal(t, test.values[i], test.text[found[i].Start:found[i].End])
+				require.Equal(t, test.wei[i], found[i].Wei.Text(10))
+				require.Equal(t, test.units[i], found[i].Unit)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import "math/big"
+
+// WeiFlag is a command-line flag holding a number of Wei.
+// It implements flag.Value, as well as pflag.Value for use with cobra.
+type WeiFlag struct {
+	// Value is the number of Wei.
+	Value *big.Int
+}
+
+// String returns the canonical string representation of the number of Wei.
+func (f *WeiFlag) String() string {
+	if f == nil {
+		return WeiToString(nil, true)
+	}
+
+	return WeiToString(f.Value, true)
+}
+
+// Set parses the input as per StringToWei.
+func (f *WeiFlag) Set(input string) error {
+	value, err := StringToWei(input)
+	if err != nil {
+		return err
+	}
+	f.Value = value
+
+	return nil
+}
+
+// Type returns the type of the flag.
+func (*WeiFlag) Type() string {
+	return "wei"
+}
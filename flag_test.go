@@ -0,0 +1,89 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"flag"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+// pflagValue is the pflag.Value interface.
+type pflagValue interface {
+	String() string
+	Set(string) error
+	Type() string
+}
+
+// Ensure that WeiFlag implements the flag interfaces.
+var (
+	_ flag.Value = &string2eth.WeiFlag{}
+	_ pflagValue = &string2eth.WeiFlag{}
+)
+
+func TestWeiFlag(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		result *big.Int
+		str    string
+		err    string
+	}{
+		{
+			name: "Unset",
+			args: []string{},
+			str:  "0",
+		},
+		{
+			name:   "Ether",
+			args:   []string{"--amount", "1.5ether"},
+			result: big.NewInt(1500000000000000000),
+			str:    "1.5 Ether",
+		},
+		{
+			name:   "GWei",
+			args:   []string{"--amount=21gwei"},
+			result: big.NewInt(21000000000),
+			str:    "21 GWei",
+		},
+		{
+			name: "Invalid",
+			args: []string{"--amount", "1 foo"},
+			err:  `invalid value "1 foo" for flag -amount: failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flags := flag.NewFlagSet("test", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			var amount string2eth.WeiFlag
+			flags.Var(&amount, "amount", "amount to send")
+			err := flags.Parse(test.args)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, amount.Value)
+				require.Equal(t, test.str, amount.String())
+				require.Equal(t, "wei", amount.Type())
+			}
+		})
+	}
+}
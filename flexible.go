@@ -0,0 +1,72 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// StringToWeiFlexible turns a string in to number of Wei, accepting the unit
+// either before or after the number, e.g. "ether 1.5" and "1.5 ether" are both
+// 1.5 Ether.  As with StringToWei the symbol "Ξ" may be used in place of the
+// ether unit, e.g. "Ξ1.5".
+// An input with more than one number or more than one unit, e.g.
+// "ether 1.5 gwei", returns ErrInvalidFormat.
+// See StringToWei for details.
+func StringToWeiFlexible(input string) (*big.Int, error) {
+	trimmed := strings.TrimSpace(input)
+	unitEnd := 0
+	for unitEnd < len(trimmed) && isLetter(trimmed[unitEnd]) {
+		unitEnd++
+	}
+	if unitEnd == 0 {
+		return StringToWei(input)
+	}
+
+	unit := trimmed[:unitEnd]
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return nil, err
+	}
+	number := strings.TrimSpace(trimmed[unitEnd:])
+	if number == "" {
+		return nil, wrapError(ErrInvalidFormat, "no number in "+strconv.Quote(input))
+	}
+	if strings.IndexFunc(number, unicode.IsSpace) != -1 {
+		return nil, wrapError(ErrInvalidFormat, "multiple numbers in "+strconv.Quote(input))
+	}
+
+	// The resolver is per-call so that the second unit is not shared.
+	var secondUnit bool
+	resolver := UnitResolverFunc(func(numberUnit string) (*big.Int, error) {
+		if numberUnit != "" {
+			secondUnit = true
+
+			return nil, wrapError(ErrInvalidFormat, "multiple units in "+strconv.Quote(input))
+		}
+
+		return multiplier, nil
+	})
+
+	result, _, err := stringToWei(number, newParseOptions(resolver))
+	if secondUnit {
+		return nil, wrapError(ErrInvalidFormat, "multiple units in "+strconv.Quote(input))
+	}
+
+	return result, err
+}
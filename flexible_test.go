@@ -0,0 +1,130 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringToWeiFlexible(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:   "UnitAfter",
+			input:  "1.5 ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "UnitBefore",
+			input:  "ether 1.5",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Symbol",
+			input:  "Ξ1.5",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "UnitBeforeNoSpace",
+			input:  "ETH1.5",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "UnitBeforePadded",
+			input:  "  gwei   21 ",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "UnitBeforeHex",
+			input:  "gwei 0x10",
+			result: big.NewInt(16000000000),
+		},
+		{
+			name:   "UnitBeforeExponent",
+			input:  "gwei 1.5e3",
+			result: big.NewInt(1500000000000),
+		},
+		{
+			name:  "UnitBeforeFractional",
+			input: "wei 1.5",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "UnitBeforeNegative",
+			input: "ether -1",
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:  "UnitBeforeUnknown",
+			input: "foo 1.5",
+			err:   string2eth.ErrUnknownUnit,
+		},
+		{
+			name:  "UnitOnly",
+			input: "ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "TwoUnits",
+			input: "ether 1.5 gwei",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "TwoUnitsSame",
+			input: "ether 1.5 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "TwoUnitsSymbol",
+			input: "ether Ξ1.5",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "TwoNumbers",
+			input: "ether 1.5 2",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "TwoNumbersUnitAfter",
+			input: "1.5 ether 2",
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiFlexible(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
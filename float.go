@@ -0,0 +1,68 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// floatPrecision is the precision, in bits, of floating point values.
+const floatPrecision = 256
+
+// WeiToBigFloat turns a number of Wei in to a floating point value in the
+// given unit, e.g. 1.5*10^18 Wei in "ether" is 1.5.
+// The value has a precision of at least 256 bits.
+func WeiToBigFloat(input *big.Int, unit string) (*big.Float, error) {
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(big.Float).SetPrec(floatPrecision)
+	if input == nil {
+		return result, nil
+	}
+	result.SetInt(input)
+
+	return result.Quo(result, new(big.Float).SetPrec(floatPrecision).SetInt(multiplier)), nil
+}
+
+// BigFloatToWei turns a floating point value in the given unit in to a number
+// of Wei, e.g. 1.5 in "ether" is 1.5*10^18 Wei.
+// As floating point values are not exact the result is rounded to the nearest
+// Wei, so any fractional part of a Wei is lost.
+func BigFloatToWei(value *big.Float, unit string) (*big.Int, error) {
+	if value == nil {
+		return nil, ErrEmptyValue
+	}
+	if value.IsInf() {
+		return nil, wrapError(ErrInvalidFormat, "infinite value")
+	}
+	if value.Sign() < 0 {
+		return nil, ErrNegative
+	}
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	wei := new(big.Float).SetPrec(floatPrecision).SetInt(multiplier)
+	wei.Mul(wei, value)
+	// Round to the nearest Wei.
+	wei.Add(wei, big.NewFloat(0.5))
+	result, _ := wei.Int(nil)
+
+	return result, nil
+}
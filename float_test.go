@@ -0,0 +1,155 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestWeiToBigFloat(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		unit   string
+		result string
+		err    string
+	}{
+		{
+			name:   "Nil",
+			unit:   "ether",
+			result: "0",
+		},
+		{
+			name:   "Ether",
+			input:  _bigInt("1230000000000000000"),
+			unit:   "ether",
+			result: "1.23",
+		},
+		{
+			name:   "GWei",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			result: "1.5",
+		},
+		{
+			name:   "OneWei",
+			input:  big.NewInt(1),
+			unit:   "ether",
+			result: "1e-18",
+		},
+		{
+			name:  "UnknownUnit",
+			input: big.NewInt(1),
+			unit:  "foo",
+			err:   "unknown unit foo",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.WeiToBigFloat(test.input, test.unit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, result.Prec(), uint(256))
+				require.Equal(t, test.result, result.Text('g', 20))
+			}
+		})
+	}
+}
+
+func TestBigFloatToWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Float
+		unit   string
+		result *big.Int
+		err    string
+	}{
+		{
+			name: "Nil",
+			unit: "ether",
+			err:  "failed to parse empty value",
+		},
+		{
+			name:   "Ether",
+			input:  big.NewFloat(1.5),
+			unit:   "ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "RoundDown",
+			input:  big.NewFloat(1.4),
+			unit:   "wei",
+			result: big.NewInt(1),
+		},
+		{
+			name:   "RoundUp",
+			input:  big.NewFloat(1.6),
+			unit:   "wei",
+			result: big.NewInt(2),
+		},
+		{
+			name:  "Negative",
+			input: big.NewFloat(-1),
+			unit:  "ether",
+			err:   "value resulted in negative number of Wei",
+		},
+		{
+			name:  "Infinite",
+			input: big.NewFloat(math.Inf(1)),
+			unit:  "ether",
+			err:   "invalid format: infinite value",
+		},
+		{
+			name:  "UnknownUnit",
+			input: big.NewFloat(1),
+			unit:  "foo",
+			err:   "unknown unit foo",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.BigFloatToWei(test.input, test.unit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestBigFloatRoundTrip(t *testing.T) {
+	for _, input := range []string{"1.23 ether", "0.000000000000000001 ether", "123456789.123456789 ether", "21 gwei"} {
+		t.Run(input, func(t *testing.T) {
+			wei, err := string2eth.StringToWei(input)
+			require.NoError(t, err)
+			value, err := string2eth.WeiToBigFloat(wei, "ether")
+			require.NoError(t, err)
+			result, err := string2eth.BigFloatToWei(value, "ether")
+			require.NoError(t, err)
+			require.Equal(t, wei, result)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// UnitFormatter selects the unit in which to display a value.
+type UnitFormatter interface {
+	// SelectUnit returns the name of the unit in which to display the value,
+	// and the number of Wei in one of that unit.
+	SelectUnit(value *big.Int) (string, *big.Int)
+}
+
+// UnitFormatterFunc is an adapter to allow the use of ordinary functions as
+// unit formatters.
+type UnitFormatterFunc func(value *big.Int) (string, *big.Int)
+
+// SelectUnit calls f(value).
+func (f UnitFormatterFunc) SelectUnit(value *big.Int) (string, *big.Int) {
+	return f(value)
+}
+
+// MetricUnitFormatter selects metric units as per WeiToString.
+type MetricUnitFormatter struct {
+	// Standard restricts output to (KMG)Wei or Ether only.
+	Standard bool
+}
+
+// SelectUnit selects the metric unit in which to display the value.
+// Values too large for the largest metric unit use the largest metric unit.
+func (f MetricUnitFormatter) SelectUnit(value *big.Int) (string, *big.Int) {
+	unitPos := 0
+	if value != nil && value.Sign() != 0 {
+		scaled, pos := weiToStringStep1(new(big.Int).Set(value))
+		outputValue, pos, desiredUnitPos, decimalPlace := weiToStringStep2(scaled, pos, f.Standard)
+		_, unitPos = weiToStringStep3(outputValue, pos, desiredUnitPos, decimalPlace)
+	}
+	if unitPos >= len(metricUnits) {
+		unitPos = len(metricUnits) - 1
+	}
+
+	return metricUnits[unitPos], new(big.Int).Set(metricMultipliers[unitPos])
+}
+
+// DefaultUnitFormatter is the unit formatter that provides the same units as
+// WeiToString in standard mode.
+var DefaultUnitFormatter UnitFormatter = MetricUnitFormatter{Standard: true}
+
+// WeiToStringWith turns a number of Wei in to a string, using the supplied
+// formatter to select the unit.
+// The value is displayed exactly if the multiplier for the unit is a power of
+// ten; for other multipliers any decimal part is truncated after as many
+// digits as the multiplier has.
+func WeiToStringWith(input *big.Int, formatter UnitFormatter) string {
+	if input == nil || input.Sign() == 0 {
+		return "0"
+	}
+	if formatter == nil {
+		formatter = DefaultUnitFormatter
+	}
+
+	name, multiplier := formatter.SelectUnit(input)
+	if multiplier == nil || multiplier.Sign() <= 0 {
+		multiplier = big.NewInt(1)
+	}
+
+	intValue, remainder := new(big.Int).QuoRem(new(big.Int).Abs(input), multiplier, new(big.Int))
+	outputValue := intValue.Text(10)
+	if remainder.Sign() != 0 {
+		// Scale the remainder to obtain the decimal digits.
+		places := len(multiplier.Text(10))
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+		decValue := new(big.Int).Quo(remainder.Mul(remainder, scale), multiplier)
+		decStr := decValue.Text(10)
+		decStr = strings.Repeat("0", places-len(decStr)) + decStr
+		if decStr = strings.TrimRight(decStr, "0"); decStr != "" {
+			outputValue += "." + decStr
+		}
+	}
+	if input.Sign() < 0 {
+		outputValue = "-" + outputValue
+	}
+
+	return outputValue + " " + name
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+// weiEtherFormatter is a unit formatter that uses only Wei and Ether.
+type weiEtherFormatter struct{}
+
+func (weiEtherFormatter) SelectUnit(value *big.Int) (string, *big.Int) {
+	ether := big.NewInt(1000000000000000000)
+	if new(big.Int).Abs(value).Cmp(ether) >= 0 {
+		return "ETH", ether
+	}
+
+	return "wei", big.NewInt(1)
+}
+
+func TestWeiToStringWith(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *big.Int
+		formatter string2eth.UnitFormatter
+		result    string
+	}{
+		{
+			name:      "Nil",
+			formatter: weiEtherFormatter{},
+			result:    "0",
+		},
+		{
+			name:      "Zero",
+			input:     big.NewInt(0),
+			formatter: weiEtherFormatter{},
+			result:    "0",
+		},
+		{
+			name:      "Wei",
+			input:     big.NewInt(21000000000),
+			formatter: weiEtherFormatter{},
+			result:    "21000000000 wei",
+		},
+		{
+			name:      "Ether",
+			input:     big.NewInt(1500000000000000000),
+			formatter: weiEtherFormatter{},
+			result:    "1.5 ETH",
+		},
+		{
+			name:      "EtherPrecise",
+			input:     big.NewInt(1000000000000000001),
+			formatter: weiEtherFormatter{},
+			result:    "1.000000000000000001 ETH",
+		},
+		{
+			name:      "LargeEther",
+			input:     _bigInt("1234000000000000000000000000000000"),
+			formatter: weiEtherFormatter{},
+			result:    "1234000000000000 ETH",
+		},
+		{
+			name:      "Negative",
+			input:     big.NewInt(-1500000000000000000),
+			formatter: weiEtherFormatter{},
+			result:    "-1.5 ETH",
+		},
+		{
+			name:      "NilFormatter",
+			input:     big.NewInt(1500000000000000000),
+			formatter: nil,
+			result:    "1.5 Ether",
+		},
+		{
+			name:      "NonStandard",
+			input:     big.NewInt(1500000000000000),
+			formatter: string2eth.MetricUnitFormatter{Standard: false},
+			result:    "1.5 Milliether",
+		},
+		{
+			name:      "BeyondYottaether",
+			input:     _bigInt("1000000000000000000000000000000000000000000000"),
+			formatter: string2eth.MetricUnitFormatter{Standard: false},
+			result:    "1000 Yottaether",
+		},
+		{
+			name:  "NonDecimalMultiplier",
+			input: big.NewInt(18),
+			formatter: string2eth.UnitFormatterFunc(func(_ *big.Int) (string, *big.Int) {
+				return "dozen", big.NewInt(12)
+			}),
+			result: "1.5 dozen",
+		},
+		{
+			name:  "NonTerminatingMultiplier",
+			input: big.NewInt(4),
+			formatter: string2eth.UnitFormatterFunc(func(_ *big.Int) (string, *big.Int) {
+				return "dozen", big.NewInt(12)
+			}),
+			result: "0.33 dozen",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringWith(test.input, test.formatter)
+			require.Equal(t, test.result, result)
+		})
+	}
+}
+
+func TestDefaultUnitFormatter(t *testing.T) {
+	inputs := []string{
+		"1",
+		"999",
+		"1000",
+		"1234",
+		"1000000000",
+		"21000000000",
+		"999999999999999",
+		"1000000000000000",
+		"1500000000000000000",
+		"1000000000000000001",
+		"123456789000000000000000",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			value := _bigInt(input)
+			require.Equal(t, string2eth.WeiToString(value, true), string2eth.WeiToStringWith(value, string2eth.DefaultUnitFormatter))
+			require.Equal(t, string2eth.WeiToString(value, false),
+				string2eth.WeiToStringWith(value, string2eth.MetricUnitFormatter{Standard: false}))
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// vulgarFractions are the Unicode vulgar fractions, with their numerators and
+// denominators.
+var vulgarFractions = map[rune][2]int64{
+	'½': {1, 2},
+	'⅓': {1, 3},
+	'⅔': {2, 3},
+	'¼': {1, 4},
+	'¾': {3, 4},
+	'⅕': {1, 5},
+	'⅖': {2, 5},
+	'⅗': {3, 5},
+	'⅘': {4, 5},
+	'⅙': {1, 6},
+	'⅚': {5, 6},
+	'⅐': {1, 7},
+	'⅛': {1, 8},
+	'⅜': {3, 8},
+	'⅝': {5, 8},
+	'⅞': {7, 8},
+	'⅑': {1, 9},
+	'⅒': {1, 10},
+}
+
+// vulgarFractionToWei turns an input containing a vulgar fraction, optionally
+// preceded by a whole number and followed by a unit, e.g. "1½ether", in to a
+// number of Wei.
+// The final return value is false if the input does not contain a vulgar
+// fraction.
+func vulgarFractionToWei(input string, opts *parseOptions) (*big.Int, string, bool, error) {
+	pos := strings.IndexFunc(input, func(r rune) bool {
+		_, exists := vulgarFractions[r]
+
+		return exists
+	})
+	if pos == -1 {
+		return nil, "", false, nil
+	}
+
+	whole := input[:pos]
+	var fraction [2]int64
+	var unit string
+	for i, r := range input[pos:] {
+		fraction = vulgarFractions[r]
+		unit = input[pos+i+len(string(r)):]
+
+		break
+	}
+	if !isDigits(whole) || !isLetters(unit) {
+		return nil, "", true, ErrInvalidFormat
+	}
+
+	wholeValue := new(big.Int)
+	if whole != "" {
+		wholeValue.SetString(whole, 10)
+	}
+	result, err := rationalToWei(wholeValue, big.NewInt(fraction[0]), big.NewInt(fraction[1]), unit, opts)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	return result, unit, true, nil
+}
+
+// rationalStringToWei turns an input containing a rational, optionally
+// followed by a unit, e.g. "1/4 ether", in to a number of Wei.
+func rationalStringToWei(input string, opts *parseOptions) (*big.Int, string, error) {
+	input = strings.TrimSpace(input)
+	numberEnd := 0
+	for numberEnd < len(input) && (isDigit(input[numberEnd]) || input[numberEnd] == '/') {
+		numberEnd++
+	}
+	unit := strings.ReplaceAll(strings.TrimLeft(input[numberEnd:], " "), "_", "")
+
+	parts := strings.Split(input[:numberEnd], "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || !isLetters(unit) {
+		return nil, "", ErrInvalidFormat
+	}
+	numerator, _ := new(big.Int).SetString(parts[0], 10)
+	denominator, _ := new(big.Int).SetString(parts[1], 10)
+	if denominator.Sign() == 0 {
+		return nil, "", ErrInvalidFormat
+	}
+
+	result, err := rationalToWei(new(big.Int), numerator, denominator, unit, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return result, unit, nil
+}
+
+// rationalToWei turns a whole number plus a fraction of the given unit in to a
+// number of Wei, returning ErrFractional if the result is not a whole number
+// of Wei and the options do not round.
+func rationalToWei(whole *big.Int,
+	numerator *big.Int,
+	denominator *big.Int,
+	unit string,
+	opts *parseOptions,
+) (
+	*big.Int,
+	error,
+) {
+	multiplier, err := opts.resolver.Multiplier(unit)
+	if err != nil {
+		return nil, unitParseError("", unit, err)
+	}
+
+	// (whole * denominator + numerator) * multiplier / denominator
+	value := new(big.Int).Mul(whole, denominator)
+	value.Add(value, numerator)
+	if err := checkRationalDecimals(value, denominator, opts); err != nil {
+		return nil, err
+	}
+	value.Mul(value, multiplier)
+
+	return divWei(value, denominator, opts)
+}
+
+// isLetters returns true if the input consists solely of ASCII letters.
+func isLetters(input string) bool {
+	for i := 0; i < len(input); i++ {
+		if !isLetter(input[i]) {
+			return false
+		}
+	}
+
+	return true
+}
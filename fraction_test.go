@@ -0,0 +1,252 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestVulgarFractionCodePoints(t *testing.T) {
+	// A unit of 2520 Wei is divisible by every supported denominator.
+	resolver := string2eth.UnitResolverFunc(func(unit string) (*big.Int, error) {
+		if unit != "x" {
+			return nil, string2eth.ErrUnknownUnit
+		}
+
+		return big.NewInt(2520), nil
+	})
+
+	tests := []struct {
+		fraction    string
+		numerator   int64
+		denominator int64
+	}{
+		{fraction: "½", numerator: 1, denominator: 2},
+		{fraction: "⅓", numerator: 1, denominator: 3},
+		{fraction: "⅔", numerator: 2, denominator: 3},
+		{fraction: "¼", numerator: 1, denominator: 4},
+		{fraction: "¾", numerator: 3, denominator: 4},
+		{fraction: "⅕", numerator: 1, denominator: 5},
+		{fraction: "⅖", numerator: 2, denominator: 5},
+		{fraction: "⅗", numerator: 3, denominator: 5},
+		{fraction: "⅘", numerator: 4, denominator: 5},
+		{fraction: "⅙", numerator: 1, denominator: 6},
+		{fraction: "⅚", numerator: 5, denominator: 6},
+		{fraction: "⅐", numerator: 1, denominator: 7},
+		{fraction: "⅛", numerator: 1, denominator: 8},
+		{fraction: "⅜", numerator: 3, denominator: 8},
+		{fraction: "⅝", numerator: 5, denominator: 8},
+		{fraction: "⅞", numerator: 7, denominator: 8},
+		{fraction: "⅑", numerator: 1, denominator: 9},
+		{fraction: "⅒", numerator: 1, denominator: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fraction, func(t *testing.T) {
+			fraction := 2520 * test.numerator / test.denominator
+
+			result, err := string2eth.StringToWeiWith(test.fraction+"x", resolver)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(fraction), result)
+
+			result, err = string2eth.StringToWeiWith("2"+test.fraction+" x", resolver)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(2*2520+fraction), result)
+		})
+	}
+}
+
+func TestVulgarFractions(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:   "Half",
+			input:  "½ ether",
+			result: big.NewInt(500000000000000000),
+		},
+		{
+			name:   "WholeAndQuarter",
+			input:  "1¼ eth",
+			result: big.NewInt(1250000000000000000),
+		},
+		{
+			name:   "WholeAndHalf",
+			input:  "1½ ether",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:   "Spaced",
+			input:  "1 ½ ether",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:  "NoUnit",
+			input: "10½",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "ThirdOfEther",
+			input: "⅓ ether",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "ThirdOfWei",
+			input: "⅓ wei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "DecimalAndFraction",
+			input: "1.5½ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "MultipleFractions",
+			input: "½½ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Negative",
+			input: "-½ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "UnknownUnit",
+			input: "½ foo",
+			err:   string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWei(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestRationals(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:   "Quarter",
+			input:  "1/4 ether",
+			result: big.NewInt(250000000000000000),
+		},
+		{
+			name:   "ThreeEighths",
+			input:  "3/8 eth",
+			result: big.NewInt(375000000000000000),
+		},
+		{
+			name:   "NoSpace",
+			input:  "1/4ether",
+			result: big.NewInt(250000000000000000),
+		},
+		{
+			name:   "Improper",
+			input:  "5/2 gwei",
+			result: big.NewInt(2500000000),
+		},
+		{
+			name:   "NoUnit",
+			input:  "10/5",
+			result: big.NewInt(2),
+		},
+		{
+			name:   "Padded",
+			input:  "  1/2   kwei ",
+			result: big.NewInt(500),
+		},
+		{
+			name:  "Third",
+			input: "1/3 ether",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "FractionalWei",
+			input: "1/2 wei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "DivisionByZero",
+			input: "1/0 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Nested",
+			input: "1/2/3 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "MissingNumerator",
+			input: "/2 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "MissingDenominator",
+			input: "1/ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "MixedNumber",
+			input: "1 1/2 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Decimal",
+			input: "1.5/2 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Negative",
+			input: "-1/2 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1/2 foo",
+			err:   string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWei(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// GasPriceWarningThreshold is the gas price, in Wei, above which
+// NormalizeGasPrice will warn that the value is unusually high.
+var GasPriceWarningThreshold = big.NewInt(10000000000000) // 10,000 GWei
+
+// NormalizeGasPrice turns a string in to a gas price in number of Wei.
+// See StringToWei for details of the accepted input.
+// In addition to the value, this returns advisory warnings for values that
+// are likely to be mistakes, for example a value in Wei that has been
+// multiplied up as if it were in GWei.  Warnings do not stop the value being
+// returned.
+func NormalizeGasPrice(input string) (*big.Int, []string, error) {
+	wei, err := StringToWei(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warnings := make([]string, 0)
+	if wei.Cmp(GasPriceWarningThreshold) > 0 {
+		suggested := new(big.Int).Div(wei, billion)
+		warnings = append(warnings, "value "+wei.Text(10)+" wei is unusually high for a gas price; did you mean "+
+			WeiToGWeiString(suggested)+"?")
+	}
+
+	return wei, warnings, nil
+}
+
+// Default bounds for ParseGasPrice.
+var (
+	defaultMinGasPrice = big.NewInt(1000)            // 1 KWei
+	defaultMaxGasPrice = big.NewInt(100000000000000) // 100,000 GWei
+)
+
+// DefaultMinGasPrice returns the default minimum gas price, in Wei, for
+// ParseGasPrice.
+// The returned value belongs to the caller, who may modify it.
+func DefaultMinGasPrice() *big.Int {
+	return new(big.Int).Set(defaultMinGasPrice)
+}
+
+// DefaultMaxGasPrice returns the default maximum gas price, in Wei, for
+// ParseGasPrice.
+// The returned value belongs to the caller, who may modify it.
+func DefaultMaxGasPrice() *big.Int {
+	return new(big.Int).Set(defaultMaxGasPrice)
+}
+
+// gasPriceOptions are the options for ParseGasPrice.
+type gasPriceOptions struct {
+	minimum *big.Int
+	maximum *big.Int
+}
+
+// GasPriceOption is an option for ParseGasPrice.
+type GasPriceOption func(*gasPriceOptions)
+
+// WithGasPriceRange sets the inclusive range, in Wei, of plausible gas prices.
+// A nil value leaves the corresponding default in place.
+// The values are copied, so later changes to them have no effect.
+func WithGasPriceRange(minimum *big.Int, maximum *big.Int) GasPriceOption {
+	if minimum != nil {
+		minimum = new(big.Int).Set(minimum)
+	}
+	if maximum != nil {
+		maximum = new(big.Int).Set(maximum)
+	}
+
+	return func(o *gasPriceOptions) {
+		if minimum != nil {
+			o.minimum = minimum
+		}
+		if maximum != nil {
+			o.maximum = maximum
+		}
+	}
+}
+
+// ParseGasPrice turns a string in to a gas price in number of Wei.
+// A value without a unit is treated as GWei, e.g. "20" is 20 GWei.
+// See StringToWei for details of the accepted input.
+// If the value is outside of the plausible range, by default 1 KWei to
+// 100,000 GWei, this returns ErrImplausibleGasPrice as the user has probably
+// confused units, e.g. "3 wei" or "2000000 gwei".
+func ParseGasPrice(input string, opts ...GasPriceOption) (*big.Int, error) {
+	options := &gasPriceOptions{
+		minimum: defaultMinGasPrice,
+		maximum: defaultMaxGasPrice,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	wei, err := StringToWeiWithDefaultUnit(input, "gwei")
+	if err != nil {
+		return nil, err
+	}
+
+	if wei.Cmp(options.minimum) < 0 || wei.Cmp(options.maximum) > 0 {
+		return nil, wrapError(ErrImplausibleGasPrice, WeiToString(wei, true)+" is outside of the range "+
+			WeiToString(options.minimum, true)+" to "+WeiToString(options.maximum, true))
+	}
+
+	return wei, nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestNormalizeGasPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		result   *big.Int
+		warnings []string
+		err      string
+	}{
+		{
+			name:  "Invalid",
+			input: "@",
+			err:   "invalid format",
+		},
+		{
+			name:     "Sane",
+			input:    "21 gwei",
+			result:   big.NewInt(21000000000),
+			warnings: []string{},
+		},
+		{
+			name:     "Threshold",
+			input:    "10000 gwei",
+			result:   big.NewInt(10000000000000),
+			warnings: []string{},
+		},
+		{
+			name:   "Absurd",
+			input:  "21000000000000000000",
+			result: _bigInt("21000000000000000000"),
+			warnings: []string{
+				"value 21000000000000000000 wei is unusually high for a gas price; did you mean 21 GWei?",
+			},
+		},
+		{
+			name:   "AbsurdWithUnit",
+			input:  "21.5 ether",
+			result: _bigInt("21500000000000000000"),
+			warnings: []string{
+				"value 21500000000000000000 wei is unusually high for a gas price; did you mean 21.5 GWei?",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, warnings, err := string2eth.NormalizeGasPrice(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+				require.Equal(t, test.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestParseGasPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		opts   []string2eth.GasPriceOption
+		result *big.Int
+		err    string
+	}{
+		{
+			name:  "Invalid",
+			input: "@",
+			err:   "invalid format",
+		},
+		{
+			name:   "DefaultUnit",
+			input:  "20",
+			result: big.NewInt(20000000000),
+		},
+		{
+			name:   "DefaultUnitDecimal",
+			input:  "0.01",
+			result: big.NewInt(10000000),
+		},
+		{
+			name:   "ExplicitUnit",
+			input:  "1.5 gwei",
+			result: big.NewInt(1500000000),
+		},
+		{
+			name:   "ExplicitWei",
+			input:  "5000 wei",
+			result: big.NewInt(5000),
+		},
+		{
+			name:   "Maximum",
+			input:  "100000",
+			result: big.NewInt(100000000000000),
+		},
+		{
+			name:  "TooLow",
+			input: "3 wei",
+			err:   "implausible gas price: 3 Wei is outside of the range 1 KWei to 100000 GWei",
+		},
+		{
+			name:  "TooHigh",
+			input: "2000000 gwei",
+			err:   "implausible gas price: 0.002 Ether is outside of the range 1 KWei to 100000 GWei",
+		},
+		{
+			name:   "CustomRange",
+			input:  "3 wei",
+			opts:   []string2eth.GasPriceOption{string2eth.WithGasPriceRange(big.NewInt(1), big.NewInt(10))},
+			result: big.NewInt(3),
+		},
+		{
+			name:  "CustomRangeTooHigh",
+			input: "11 wei",
+			opts:  []string2eth.GasPriceOption{string2eth.WithGasPriceRange(big.NewInt(1), big.NewInt(10))},
+			err:   "implausible gas price: 11 Wei is outside of the range 1 Wei to 10 Wei",
+		},
+		{
+			name:   "CustomMaximumOnly",
+			input:  "2000000",
+			opts:   []string2eth.GasPriceOption{string2eth.WithGasPriceRange(nil, _bigInt("1000000000000000000"))},
+			result: big.NewInt(2000000000000000),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.ParseGasPrice(test.input, test.opts...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestParseGasPriceImplausible(t *testing.T) {
+	_, err := string2eth.ParseGasPrice("3 wei")
+	require.ErrorIs(t, err, string2eth.ErrImplausibleGasPrice)
+}
+
+func TestGasPriceDefaultsImmutable(t *testing.T) {
+	minimum := string2eth.DefaultMinGasPrice()
+	require.Equal(t, big.NewInt(1000), minimum)
+	minimum.SetInt64(1000000000)
+	require.Equal(t, big.NewInt(1000), string2eth.DefaultMinGasPrice())
+
+	maximum := string2eth.DefaultMaxGasPrice()
+	require.Equal(t, big.NewInt(100000000000000), maximum)
+	maximum.SetInt64(1)
+	require.Equal(t, big.NewInt(100000000000000), string2eth.DefaultMaxGasPrice())
+
+	// Defaults are unaffected by changes to the returned values.
+	_, err := string2eth.ParseGasPrice("20 gwei")
+	require.NoError(t, err)
+}
+
+func TestWithGasPriceRangeCopies(t *testing.T) {
+	minimum := big.NewInt(1000000000)
+	maximum := big.NewInt(2000000000)
+	opt := string2eth.WithGasPriceRange(minimum, maximum)
+
+	// Changes to the arguments after the option is created have no effect.
+	minimum.SetInt64(1)
+	maximum.SetInt64(1)
+	result, err := string2eth.ParseGasPrice("1.5 gwei", opt)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1500000000), result)
+
+	_, err = string2eth.ParseGasPrice("1 wei", opt)
+	require.ErrorIs(t, err, string2eth.ErrImplausibleGasPrice)
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"errors"
+	"math/big"
+)
+
+// GWei is a number of GWei, as commonly used for gas prices.
+// It is distinct from a number of Wei to avoid confusion between the two.
+type GWei uint64
+
+// ParseGWei turns a string in to a number of GWei.
+// Values that are not an exact number of GWei, e.g. "1.5 gwei", return
+// ErrFractional rather than losing the part below 1 GWei.
+// See StringToWei for details of the accepted input.
+func ParseGWei(input string) (GWei, error) {
+	gwei, err := StringToGWeiExact(input)
+	if errors.Is(err, ErrFractional) {
+		return 0, ErrFractional
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return GWei(gwei), nil
+}
+
+// ToWei returns the number of Wei.
+func (g GWei) ToWei() *big.Int {
+	return GWeiToWei(uint64(g))
+}
+
+// String returns the canonical string representation of the number of GWei.
+func (g GWei) String() string {
+	return GWeiToString(uint64(g), true)
+}
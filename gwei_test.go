@@ -0,0 +1,82 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseGWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result string2eth.GWei
+		err    string
+	}{
+		{
+			name:   "GWei",
+			input:  "21 gwei",
+			result: 21,
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ether",
+			result: 1500000000,
+		},
+		{
+			name:   "Wei",
+			input:  "1000000000",
+			result: 1,
+		},
+		{
+			name:  "SubGWei",
+			input: "1.5 gwei",
+			err:   "value resulted in fractional number of Wei",
+		},
+		{
+			name:  "Invalid",
+			input: "1 foo",
+			err:   `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.ParseGWei(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestGWeiToWei(t *testing.T) {
+	require.Equal(t, big.NewInt(21000000000), string2eth.GWei(21).ToWei())
+	require.Equal(t, big.NewInt(0), string2eth.GWei(0).ToWei())
+	require.Equal(t, "18446744073709551615000000000", string2eth.GWei(^uint64(0)).ToWei().String())
+}
+
+func TestGWeiString(t *testing.T) {
+	require.Equal(t, "21 GWei", string2eth.GWei(21).String())
+	require.Equal(t, "1.5 Ether", string2eth.GWei(1500000000).String())
+	require.Equal(t, "0", string2eth.GWei(0).String())
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DefaultMaxInputLength is the default maximum number of characters in an
+// input, excluding leading and trailing whitespace.  This is well in excess of
+// any legitimate value, e.g. a teraether value with 18 decimal places, but
+// stops untrusted input from causing excessive work.
+const DefaultMaxInputLength = 256
+
+// checkInputLength returns ErrValueTooLong if the input is longer than the
+// maximum input length.
+func checkInputLength(input string, maxLength int) error {
+	if maxLength <= 0 {
+		// No limit.
+		return nil
+	}
+
+	// Count no further than required, as the input may be very long.
+	length := 0
+	for range strings.TrimSpace(input) {
+		length++
+		if length > maxLength {
+			return wrapError(ErrValueTooLong, "more than "+strconv.Itoa(maxLength)+" characters")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringToWeiInputLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result string
+		err    error
+	}{
+		{
+			name:   "Teraether",
+			input:  "999999999999.999999999999999999 teraether",
+			result: "999999999999999999999999999999000000000000",
+		},
+		{
+			name:   "AtLimit",
+			input:  "1." + strings.Repeat("0", 248) + " ether",
+			result: "1000000000000000000",
+		},
+		{
+			name:   "SurroundingWhitespace",
+			input:  strings.Repeat(" ", 100) + "1." + strings.Repeat("0", 248) + " ether" + strings.Repeat(" ", 100),
+			result: "1000000000000000000",
+		},
+		{
+			name:  "OverLimit",
+			input: "1." + strings.Repeat("0", 249) + " ether",
+			err:   string2eth.ErrValueTooLong,
+		},
+		{
+			name:  "HugeDigits",
+			input: strings.Repeat("9", 5*1024*1024),
+			err:   string2eth.ErrValueTooLong,
+		},
+		{
+			name:  "HugeDecimal",
+			input: "0." + strings.Repeat("1", 5*1024*1024) + " ether",
+			err:   string2eth.ErrValueTooLong,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWei(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result.Text(10))
+			}
+
+			// Validation and byte parsing must agree with StringToWei.
+			validateErr := string2eth.ValidateWeiString(test.input)
+			_, bytesErr := string2eth.ParseWeiBytes([]byte(test.input))
+			if test.err != nil {
+				require.ErrorIs(t, validateErr, test.err)
+				require.ErrorIs(t, bytesErr, test.err)
+			} else {
+				require.NoError(t, validateErr)
+				require.NoError(t, bytesErr)
+			}
+		})
+	}
+}
+
+func TestInputLengthEntryPoints(t *testing.T) {
+	digits := strings.Repeat("9", 5*1024*1024)
+
+	tests := []struct {
+		name  string
+		parse func() error
+	}{
+		{
+			name: "StringToWeiStrict",
+			parse: func() error {
+				_, err := string2eth.StringToWeiStrict(digits + " ether")
+				return err
+			},
+		},
+		{
+			name: "Parse",
+			parse: func() error {
+				_, _, _, _, err := string2eth.Parse(digits + " ether")
+				return err
+			},
+		},
+		{
+			name: "ParseStrictDecimal18",
+			parse: func() error {
+				_, err := string2eth.ParseStrictDecimal18(digits + ".000000000000000000")
+				return err
+			},
+		},
+		{
+			name: "ParseRange",
+			parse: func() error {
+				_, _, err := string2eth.ParseRange("1-" + digits + " ether")
+				return err
+			},
+		},
+		{
+			name: "UnitToMultiplier",
+			parse: func() error {
+				_, err := string2eth.UnitToMultiplier(strings.Repeat("e", 5*1024*1024))
+				return err
+			},
+		},
+		{
+			name: "SameUnit",
+			parse: func() error {
+				_, err := string2eth.SameUnit("ether", strings.Repeat("e", 5*1024*1024))
+				return err
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.ErrorIs(t, test.parse(), string2eth.ErrValueTooLong)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// StringToWeiLenient turns a string in to number of Wei, detecting whether a
+// comma or a period is used as the decimal separator.  It is intended for
+// input where the locale of the user is unknown.
+//
+// The separator is resolved as follows:
+//   - a number containing both commas and periods uses the last of the two as
+//     the decimal separator and the other for grouping, e.g. "1.234,56" and
+//     "1,234.56"
+//   - a number with a single period uses it as the decimal separator, as per
+//     StringToWei, e.g. "1.234"
+//   - a number with multiple commas or multiple periods uses them for
+//     grouping, e.g. "1,234,567" or "1.234.567"
+//   - a number with a single comma uses it as the decimal separator if it
+//     cannot be grouping, i.e. it is followed by other than three digits or
+//     preceded only by "0", e.g. "1,5", "12,34" or "0,123"
+//
+// A number with a single comma followed by exactly three digits, e.g. "1,234",
+// could be either grouping or decimal and returns ErrAmbiguousSeparator.
+// Grouping must be in well-formed groups of three digits.
+// See StringToWei for details of unit handling.
+func StringToWeiLenient(input string) (*big.Int, error) {
+	input = normaliseWhitespace(input)
+	if strings.TrimSpace(input) == "" {
+		return nil, ErrEmptyValue
+	}
+
+	input = strings.ReplaceAll(input, " ", "")
+	input = strings.ReplaceAll(input, "_", "")
+	numberEnd := strings.IndexFunc(input, func(r rune) bool {
+		return !(r == '-' || r == ',' || r == '.' || (r >= '0' && r <= '9'))
+	})
+	if numberEnd == -1 {
+		numberEnd = len(input)
+	}
+
+	number, err := resolveSeparators(input[:numberEnd])
+	if err != nil {
+		return nil, err
+	}
+
+	return StringToWei(number + input[numberEnd:])
+}
+
+// resolveSeparators returns the number with grouping removed and a period as
+// the decimal separator.
+func resolveSeparators(number string) (string, error) {
+	commas := strings.Count(number, ",")
+	periods := strings.Count(number, ".")
+
+	switch {
+	case commas > 0 && periods > 0:
+		decimal, group := ".", ","
+		if strings.LastIndex(number, ",") > strings.LastIndex(number, ".") {
+			decimal, group = ",", "."
+		}
+		if strings.Count(number, decimal) > 1 {
+			return "", wrapError(ErrInvalidFormat, "multiple decimal separators in "+number)
+		}
+		intPart, decPart, _ := strings.Cut(number, decimal)
+
+		return ungroup(intPart, group, number, "."+decPart)
+	case periods > 1:
+		return ungroup(number, ".", number, "")
+	case commas > 1:
+		return ungroup(number, ",", number, "")
+	case commas == 1:
+		intPart, decPart, _ := strings.Cut(number, ",")
+		if len(decPart) == 3 && strings.TrimLeft(intPart, "-") != "0" {
+			return "", wrapError(ErrAmbiguousSeparator, number+" could be grouping or decimal")
+		}
+
+		return intPart + "." + decPart, nil
+	default:
+		return number, nil
+	}
+}
+
+// ungroup removes grouping from an integer, ensuring that it is well-formed.
+func ungroup(intPart string, group string, number string, suffix string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(intPart, "-") {
+		sign = "-"
+		intPart = intPart[1:]
+	}
+
+	groups := strings.Split(intPart, group)
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", wrapError(ErrInvalidFormat, "invalid grouping in "+number)
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return "", wrapError(ErrInvalidFormat, "invalid grouping in "+number)
+		}
+	}
+
+	return sign + strings.Join(groups, "") + suffix, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringToWeiLenient(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:  "Whitespace",
+			input: " \t ",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:   "NonBreakingSpace",
+			input:  "1,5\u00a0eth",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "Plain",
+			input:  "15 eth",
+			result: _bigInt("15000000000000000000"),
+		},
+		{
+			name:   "PeriodDecimal",
+			input:  "1.5 eth",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "CommaDecimal",
+			input:  "1,5 eth",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "CommaDecimalTwoDigits",
+			input:  "12,34 ether",
+			result: _bigInt("12340000000000000000"),
+		},
+		{
+			name:   "CommaDecimalManyDigits",
+			input:  "1,2345 ether",
+			result: _bigInt("1234500000000000000"),
+		},
+		{
+			name:   "CommaDecimalLeadingZero",
+			input:  "0,123 ether",
+			result: _bigInt("123000000000000000"),
+		},
+		{
+			name:   "EuropeanGrouping",
+			input:  "1.234,56 ether",
+			result: _bigInt("1234560000000000000000"),
+		},
+		{
+			name:   "EnglishGrouping",
+			input:  "1,234.56 ether",
+			result: _bigInt("1234560000000000000000"),
+		},
+		{
+			name:   "MultipleCommas",
+			input:  "1,234,567 gwei",
+			result: _bigInt("1234567000000000"),
+		},
+		{
+			name:   "MultiplePeriods",
+			input:  "1.234.567 gwei",
+			result: _bigInt("1234567000000000"),
+		},
+		{
+			name:   "SinglePeriodThreeDigits",
+			input:  "1.234 ether",
+			result: _bigInt("1234000000000000000"),
+		},
+		{
+			name:  "Ambiguous",
+			input: "1,234 ether",
+			err:   "ambiguous decimal separator: 1,234 could be grouping or decimal",
+		},
+		{
+			name:  "BadGrouping",
+			input: "1,23,456 ether",
+			err:   "invalid format: invalid grouping in 1,23,456",
+		},
+		{
+			name:  "BadMixedGrouping",
+			input: "1.23,5 ether",
+			err:   "invalid format: invalid grouping in 1.23,5",
+		},
+		{
+			name:  "MultipleDecimals",
+			input: "1.234,5,6 ether",
+			err:   "invalid format: multiple decimal separators in 1.234,5,6",
+		},
+		{
+			name:  "Negative",
+			input: "-1,5 eth",
+			err:   "value resulted in negative number of Wei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiLenient(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
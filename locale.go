@@ -0,0 +1,221 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !string2eth_tiny
+
+package string2eth
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// localeFormat contains the number formatting conventions for a locale.
+type localeFormat struct {
+	name string
+	// decimal is the decimal separator.
+	decimal rune
+	// group is the digit grouping separator, or 0 if the locale does not group.
+	group rune
+	// primary is the size of the group immediately before the decimal separator.
+	primary int
+	// secondary is the size of subsequent groups.
+	secondary int
+}
+
+// newLocaleFormat obtains the number formatting conventions for a locale by
+// formatting a known number and inspecting the output.
+func newLocaleFormat(tag language.Tag) *localeFormat {
+	runes := []rune(message.NewPrinter(tag).Sprintf("%.1f", 12345678.5))
+	format := &localeFormat{
+		name:    tag.String(),
+		decimal: runes[len(runes)-2],
+	}
+
+	intPart := runes[:len(runes)-2]
+	for _, r := range intPart {
+		if !unicode.IsDigit(r) {
+			format.group = r
+
+			break
+		}
+	}
+	groups := strings.FieldsFunc(string(intPart), func(r rune) bool { return !unicode.IsDigit(r) })
+	format.primary = len([]rune(groups[len(groups)-1]))
+	format.secondary = format.primary
+	if len(groups) > 2 {
+		format.secondary = len([]rune(groups[len(groups)-2]))
+	}
+
+	return format
+}
+
+// isGroup returns true if the rune is the grouping separator for the locale.
+// Locales that group with a space accept any form of space, as users commonly
+// enter a regular space in place of a (narrow) no-break space.
+func (f *localeFormat) isGroup(r rune) bool {
+	if f.group == 0 {
+		return false
+	}
+	if unicode.IsSpace(f.group) {
+		return unicode.IsSpace(r)
+	}
+
+	return r == f.group
+}
+
+// StringToWeiIn turns a string in to number of Wei, using the digit grouping
+// and decimal separator conventions of the given locale.  For example, with a
+// tag of de-DE the input "1.234,5 Ether" is 1234.5 Ether.
+// Input that does not follow the conventions of the locale, such as "1.5 Ether"
+// for de-DE, is rejected rather than guessed at.
+// See StringToWei for details of unit handling.
+func StringToWeiIn(input string, tag language.Tag) (*big.Int, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, ErrEmptyValue
+	}
+	format := newLocaleFormat(tag)
+
+	// Separate the number from the unit.
+	runes := []rune(input)
+	numberEnd := 0
+	for numberEnd < len(runes) {
+		r := runes[numberEnd]
+		if !unicode.IsDigit(r) && r != '-' && r != format.decimal && !format.isGroup(r) {
+			break
+		}
+		numberEnd++
+	}
+	number := strings.TrimRightFunc(string(runes[:numberEnd]), unicode.IsSpace)
+	unit := string(runes[numberEnd:])
+
+	canonical, err := format.canonicalNumber(number)
+	if err != nil {
+		return nil, err
+	}
+
+	return StringToWei(canonical + unit)
+}
+
+// canonicalNumber turns a number formatted for the locale in to a number
+// with no grouping and a period as the decimal separator.
+func (f *localeFormat) canonicalNumber(number string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign = "-"
+		number = number[1:]
+	}
+
+	parts := strings.Split(number, string(f.decimal))
+	if len(parts) > 2 {
+		return "", wrapError(ErrInvalidFormat, strconv.Quote(number)+" contains multiple decimal separators for "+f.name+
+			", which uses "+strconv.QuoteRune(f.decimal)+" as the decimal separator")
+	}
+	if len(parts) == 2 && strings.IndexFunc(parts[1], func(r rune) bool { return !unicode.IsDigit(r) }) != -1 {
+		return "", wrapError(ErrInvalidFormat, strconv.Quote(number)+" contains non-digits after the decimal separator "+
+			strconv.QuoteRune(f.decimal)+" for "+f.name)
+	}
+
+	groups := strings.FieldsFunc(parts[0], f.isGroup)
+	if !f.validGroups(parts[0], groups) {
+		return "", wrapError(ErrInvalidFormat, strconv.Quote(number)+" is not correctly grouped for "+f.name+
+			", which uses "+strconv.QuoteRune(f.group)+" to group digits and "+strconv.QuoteRune(f.decimal)+" as the decimal separator")
+	}
+
+	res := sign + strings.Join(groups, "")
+	if len(parts) == 2 {
+		res += "." + parts[1]
+	}
+
+	return res, nil
+}
+
+// validGroups returns true if the digit groups of an integer are correctly
+// sized for the locale.  An integer without grouping is always valid.
+func (f *localeFormat) validGroups(integer string, groups []string) bool {
+	for _, group := range groups {
+		if strings.IndexFunc(group, func(r rune) bool { return !unicode.IsDigit(r) }) != -1 {
+			return false
+		}
+	}
+	if len(groups) <= 1 {
+		// Separators must be between digits.
+		return len(groups) == 1 && groups[0] == integer || integer == ""
+	}
+
+	// Separators must be single runes between digits.
+	if len([]rune(integer)) != len([]rune(strings.Join(groups, "")))+len(groups)-1 {
+		return false
+	}
+	if first := len(groups[0]); first == 0 || first > f.secondary {
+		return false
+	}
+	for _, group := range groups[1 : len(groups)-1] {
+		if len(group) != f.secondary {
+			return false
+		}
+	}
+
+	return len(groups[len(groups)-1]) == f.primary
+}
+
+// WeiToStringForLanguage turns a number of Wei in to a string, as per
+// WeiToString, with the number formatted using the digit grouping and decimal
+// separator conventions of the given language.  For example, with a tag of
+// German 10^21 Wei is "1.000 Ether" and 1234567 Wei is "1,234567 MWei".
+// The unit is not translated.
+func WeiToStringForLanguage(input *big.Int, standard bool, tag language.Tag) string {
+	output := WeiToString(input, standard)
+	number, unit, hasUnit := strings.Cut(output, " ")
+	if !hasUnit {
+		// Output without a unit, e.g. "0", is returned unchanged.
+		return output
+	}
+
+	return newLocaleFormat(tag).localNumber(number) + " " + unit
+}
+
+// localNumber turns a number with no grouping and a period as the decimal
+// separator in to a number formatted for the locale.
+func (f *localeFormat) localNumber(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign = "-"
+		number = number[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+
+	if f.group != 0 && len(integer) > f.primary {
+		groups := []string{integer[len(integer)-f.primary:]}
+		integer = integer[:len(integer)-f.primary]
+		for len(integer) > f.secondary {
+			groups = append([]string{integer[len(integer)-f.secondary:]}, groups...)
+			integer = integer[:len(integer)-f.secondary]
+		}
+		integer = strings.Join(append([]string{integer}, groups...), string(f.group))
+	}
+
+	res := sign + integer
+	if hasFraction {
+		res += string(f.decimal) + fraction
+	}
+
+	return res
+}
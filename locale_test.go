@@ -0,0 +1,256 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !string2eth_tiny
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+	"golang.org/x/text/language"
+)
+
+func TestStringToWeiIn(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		tag    language.Tag
+		result *big.Int
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			tag:   language.German,
+			err:   "failed to parse empty value",
+		},
+		{
+			name:   "English",
+			input:  "1,234.5 Ether",
+			tag:    language.English,
+			result: _bigInt("1234500000000000000000"),
+		},
+		{
+			name:   "German",
+			input:  "1.234,5 Ether",
+			tag:    language.MustParse("de-DE"),
+			result: _bigInt("1234500000000000000000"),
+		},
+		{
+			name:   "GermanNoGrouping",
+			input:  "1234,5 Ether",
+			tag:    language.MustParse("de-DE"),
+			result: _bigInt("1234500000000000000000"),
+		},
+		{
+			name:   "GermanMillions",
+			input:  "1.234.567 gwei",
+			tag:    language.MustParse("de-DE"),
+			result: _bigInt("1234567000000000"),
+		},
+		{
+			name:   "GermanDecimal",
+			input:  "0,5ether",
+			tag:    language.MustParse("de-DE"),
+			result: _bigInt("500000000000000000"),
+		},
+		{
+			name:  "GermanAmbiguous",
+			input: "1.5 Ether",
+			tag:   language.MustParse("de-DE"),
+			err:   `invalid format: "1.5" is not correctly grouped for de-DE, which uses '.' to group digits and ',' as the decimal separator`,
+		},
+		{
+			name:  "GermanEnglishFormat",
+			input: "1,234.5 Ether",
+			tag:   language.MustParse("de-DE"),
+			err:   `invalid format: "1,234.5" contains non-digits after the decimal separator ',' for de-DE`,
+		},
+		{
+			name:  "GermanMultipleDecimals",
+			input: "1,2,3 Ether",
+			tag:   language.MustParse("de-DE"),
+			err:   `invalid format: "1,2,3" contains multiple decimal separators for de-DE, which uses ',' as the decimal separator`,
+		},
+		{
+			name:   "FrenchNarrowNoBreakSpace",
+			input:  "1\u202f234,5 Ether",
+			tag:    language.MustParse("fr-FR"),
+			result: _bigInt("1234500000000000000000"),
+		},
+		{
+			name:   "FrenchNoBreakSpace",
+			input:  "1\u00a0234\u00a0567,25 gwei",
+			tag:    language.MustParse("fr-FR"),
+			result: _bigInt("1234567250000000"),
+		},
+		{
+			name:   "FrenchSpace",
+			input:  "1 234,5 Ether",
+			tag:    language.MustParse("fr-FR"),
+			result: _bigInt("1234500000000000000000"),
+		},
+		{
+			name:  "FrenchBadGrouping",
+			input: "12 34,5 Ether",
+			tag:   language.MustParse("fr-FR"),
+			err:   `invalid format: "12 34,5" is not correctly grouped for fr-FR, which uses '\u00a0' to group digits and ',' as the decimal separator`,
+		},
+		{
+			name:   "IndianLakh",
+			input:  "1,00,000 wei",
+			tag:    language.MustParse("en-IN"),
+			result: big.NewInt(100000),
+		},
+		{
+			name:   "IndianCrore",
+			input:  "1,23,45,678.5 gwei",
+			tag:    language.MustParse("en-IN"),
+			result: big.NewInt(12345678500000000),
+		},
+		{
+			name:   "IndianThousands",
+			input:  "12,345",
+			tag:    language.MustParse("en-IN"),
+			result: big.NewInt(12345),
+		},
+		{
+			name:  "IndianWesternGrouping",
+			input: "100,000 wei",
+			tag:   language.MustParse("en-IN"),
+			err:   `invalid format: "100,000" is not correctly grouped for en-IN, which uses ',' to group digits and '.' as the decimal separator`,
+		},
+		{
+			name:  "WesternIndianGrouping",
+			input: "1,00,000 wei",
+			tag:   language.English,
+			err:   `invalid format: "1,00,000" is not correctly grouped for en, which uses ',' to group digits and '.' as the decimal separator`,
+		},
+		{
+			name:  "Negative",
+			input: "-1,5 Ether",
+			tag:   language.MustParse("de-DE"),
+			err:   "value resulted in negative number of Wei",
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1,5 foo",
+			tag:   language.MustParse("de-DE"),
+			err:   `failed to parse "1.5 foo" at offset 4 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiIn(test.input, test.tag)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestWeiToStringForLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Int
+		standard bool
+		tag      language.Tag
+		result   string
+	}{
+		{
+			name:   "Nil",
+			tag:    language.German,
+			result: "0",
+		},
+		{
+			name:   "EnglishSmall",
+			input:  big.NewInt(1234567),
+			tag:    language.English,
+			result: "1.234567 MWei",
+		},
+		{
+			name:     "EnglishGrouped",
+			input:    _bigInt("1000000000000000000000"),
+			standard: true,
+			tag:      language.English,
+			result:   "1,000 Ether",
+		},
+		{
+			name:   "EnglishLarge",
+			input:  _bigInt("1234567500000000000000000"),
+			tag:    language.English,
+			result: "1.2345675 Megaether",
+		},
+		{
+			name:     "EnglishStandardLarge",
+			input:    _bigInt("1234567500000000000000000"),
+			standard: true,
+			tag:      language.English,
+			result:   "1,234,567.5 Ether",
+		},
+		{
+			name:   "GermanSmall",
+			input:  big.NewInt(1234567),
+			tag:    language.German,
+			result: "1,234567 MWei",
+		},
+		{
+			name:     "GermanGrouped",
+			input:    _bigInt("1000000000000000000000"),
+			standard: true,
+			tag:      language.German,
+			result:   "1.000 Ether",
+		},
+		{
+			name:     "GermanStandardLarge",
+			input:    _bigInt("-1234567500000000000000000"),
+			standard: true,
+			tag:      language.German,
+			result:   "-1.234.567,5 Ether",
+		},
+		{
+			name:   "FrenchSmall",
+			input:  big.NewInt(1234567),
+			tag:    language.French,
+			result: "1,234567 MWei",
+		},
+		{
+			name:     "FrenchStandardLarge",
+			input:    _bigInt("1234567500000000000000000"),
+			standard: true,
+			tag:      language.French,
+			result:   "1\u00a0234\u00a0567,5 Ether",
+		},
+		{
+			name:   "Overflow",
+			input:  _bigInt("1000000000000000000000000000000000000000000000"),
+			tag:    language.German,
+			result: "overflow",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.result, string2eth.WeiToStringForLanguage(test.input, test.standard, test.tag))
+		})
+	}
+}
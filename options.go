@@ -0,0 +1,207 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// formatOptions are the options for WeiToStringOptions.
+type formatOptions struct {
+	standard      bool
+	fixedUnit     string
+	decimals      int
+	hasDecimals   bool
+	groupSep      rune
+	trailingZeros bool
+	unitFirst     bool
+}
+
+// FormatOption is an option for WeiToStringOptions.
+type FormatOption func(*formatOptions)
+
+// WithStandardUnits restricts output to (KMG)Wei or Ether, as per the
+// standard flag of WeiToString.
+func WithStandardUnits() FormatOption {
+	return func(o *formatOptions) {
+		o.standard = true
+	}
+}
+
+// WithFixedUnit displays the value in the given unit rather than selecting
+// one, e.g. "1000000000 GWei" for 1 Ether with unit "gwei".  The unit can be
+// any name accepted by UnitToMultiplier.
+func WithFixedUnit(unit string) FormatOption {
+	return func(o *formatOptions) {
+		o.fixedUnit = unit
+	}
+}
+
+// WithDecimals displays at most the given number of decimal places, rounding
+// the value half up, e.g. "1.2346 Ether" for 1.23456 Ether with 4 decimals.
+func WithDecimals(decimals int) FormatOption {
+	return func(o *formatOptions) {
+		o.decimals = decimals
+		o.hasDecimals = decimals >= 0
+	}
+}
+
+// WithGrouping inserts the separator between each group of three digits in
+// the integer part of the value, e.g. "1,000 Ether".
+func WithGrouping(groupSep rune) FormatOption {
+	return func(o *formatOptions) {
+		o.groupSep = groupSep
+	}
+}
+
+// WithTrailingZeros retains trailing zeros in the decimal part of the value,
+// up to the number of places given by WithDecimals or, without that option,
+// the number of places needed for a single Wei in the unit, e.g.
+// "1.500000000 GWei".
+func WithTrailingZeros(trailingZeros bool) FormatOption {
+	return func(o *formatOptions) {
+		o.trailingZeros = trailingZeros
+	}
+}
+
+// WithUnitFirst places the unit before the value, as used by spreadsheets and
+// accounting exports, e.g. "ETH 1.5" rather than "1.5 Ether".  Ether is shown
+// with its ticker "ETH"; other units use their usual names, e.g. "GWei 21".
+func WithUnitFirst() FormatOption {
+	return func(o *formatOptions) {
+		o.unitFirst = true
+	}
+}
+
+// WeiToStringOptions turns a number of Wei in to a string, subject to the
+// supplied options.
+// With no options the output is as per WeiToString in non-standard mode.  As
+// with WeiToString, a zero value without a fixed unit is "0" and a value too
+// large for any unit is "overflow".  If a fixed unit is not known this returns
+// "unknown unit".
+func WeiToStringOptions(input *big.Int, opts ...FormatOption) string {
+	options := &formatOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	var unitPos int
+	if options.fixedUnit != "" {
+		var err error
+		unitPos, err = unitToMetricPos(options.fixedUnit)
+		if err != nil {
+			return ErrUnknownUnit.Error()
+		}
+	} else {
+		if input == nil || input.Sign() == 0 {
+			return "0"
+		}
+		unitPos = bestUnitPos(input, options.standard)
+		if unitPos >= len(metricUnits) {
+			return "overflow"
+		}
+	}
+
+	var output string
+	if options.hasDecimals {
+		output = WeiToStringFixed(input, metricUnits[unitPos], options.decimals, RoundHalfUp)
+		if !options.trailingZeros {
+			output = trimTrailingZeros(output)
+		}
+	} else {
+		output = weiToUnitString(input, unitPos)
+		if options.trailingZeros {
+			output = padTrailingZeros(output, unitPos*3)
+		}
+	}
+
+	if options.groupSep != 0 {
+		output = groupOutput(output, options.groupSep)
+	}
+	if options.unitFirst {
+		output = unitFirst(output)
+	}
+
+	return output
+}
+
+// trimTrailingZeros removes trailing zeros from the decimal part of formatted
+// output, along with the decimal point if nothing remains after it.
+func trimTrailingZeros(output string) string {
+	number, unit, _ := strings.Cut(output, " ")
+	if strings.Contains(number, ".") {
+		number = strings.TrimSuffix(strings.TrimRight(number, "0"), ".")
+	}
+
+	return number + " " + unit
+}
+
+// padTrailingZeros pads the decimal part of formatted output with zeros to
+// the given number of places.
+func padTrailingZeros(output string, places int) string {
+	if places == 0 {
+		return output
+	}
+	number, unit, _ := strings.Cut(output, " ")
+	integer, fraction, _ := strings.Cut(number, ".")
+
+	return integer + "." + fraction + strings.Repeat("0", places-len(fraction)) + " " + unit
+}
+
+// groupOutput inserts the separator between each group of three digits in
+// the integer part of formatted output.  Output without a unit, e.g. "0", is
+// returned unchanged.
+func groupOutput(output string, groupSep rune) string {
+	number, unit, hasUnit := strings.Cut(output, " ")
+	if !hasUnit {
+		return output
+	}
+	integer, fraction, hasFraction := strings.Cut(number, ".")
+	sign := ""
+	if strings.HasPrefix(integer, "-") {
+		sign = "-"
+		integer = integer[1:]
+	}
+
+	var builder strings.Builder
+	builder.WriteString(sign)
+	for i := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			builder.WriteRune(groupSep)
+		}
+		builder.WriteByte(integer[i])
+	}
+	if hasFraction {
+		builder.WriteString("." + fraction)
+	}
+	builder.WriteString(" " + unit)
+
+	return builder.String()
+}
+
+// unitFirst moves the unit of formatted output before the number.  Output
+// without a unit, e.g. "0", is returned unchanged.
+func unitFirst(output string) string {
+	number, unit, found := strings.Cut(output, " ")
+	if !found {
+		return output
+	}
+	if unit == metricUnits[etherPos] {
+		unit = "ETH"
+	}
+
+	return unit + " " + number
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestWeiToStringOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		opts   []string2eth.FormatOption
+		result string
+	}{
+		{
+			name:   "Nil",
+			input:  nil,
+			opts:   []string2eth.FormatOption{string2eth.WithUnitFirst()},
+			result: "0",
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			opts:   []string2eth.FormatOption{string2eth.WithUnitFirst()},
+			result: "0",
+		},
+		{
+			name:   "NoOptions",
+			input:  _bigInt("1500000000000000"),
+			result: "1.5 Milliether",
+		},
+		{
+			name:   "NilNoOptions",
+			input:  nil,
+			result: "0",
+		},
+		{
+			name:   "Standard",
+			input:  _bigInt("1500000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithStandardUnits()},
+			result: "0.0015 Ether",
+		},
+		{
+			name:   "UnitFirstEther",
+			input:  _bigInt("1500000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithUnitFirst()},
+			result: "ETH 1.5",
+		},
+		{
+			name:   "UnitFirstGWei",
+			input:  big.NewInt(21000000000),
+			opts:   []string2eth.FormatOption{string2eth.WithUnitFirst()},
+			result: "GWei 21",
+		},
+		{
+			name:   "UnitFirstNegative",
+			input:  _bigInt("-1500000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithUnitFirst()},
+			result: "ETH -1.5",
+		},
+		{
+			name:   "FixedUnit",
+			input:  _bigInt("1000000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithFixedUnit("gwei")},
+			result: "1000000000 GWei",
+		},
+		{
+			name:   "FixedUnitZero",
+			input:  big.NewInt(0),
+			opts:   []string2eth.FormatOption{string2eth.WithFixedUnit("ether")},
+			result: "0 Ether",
+		},
+		{
+			name:   "FixedUnitUnknown",
+			input:  big.NewInt(1),
+			opts:   []string2eth.FormatOption{string2eth.WithFixedUnit("foo")},
+			result: "unknown unit",
+		},
+		{
+			name:   "FixedUnitLarge",
+			input:  _bigInt("1000000000000000000000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithFixedUnit("ether")},
+			result: "1000000000000000 Ether",
+		},
+		{
+			name:   "Overflow",
+			input:  _bigInt("1000000000000000000000000000000000000000000000"),
+			result: "overflow",
+		},
+		{
+			name:   "Decimals",
+			input:  _bigInt("1234567890000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithDecimals(4)},
+			result: "1.2346 Ether",
+		},
+		{
+			name:   "DecimalsTrimmed",
+			input:  _bigInt("1500000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithDecimals(4)},
+			result: "1.5 Ether",
+		},
+		{
+			name:   "DecimalsZero",
+			input:  _bigInt("1500000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithDecimals(0)},
+			result: "2 Ether",
+		},
+		{
+			name:   "DecimalsNegative",
+			input:  _bigInt("1234567890000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithDecimals(-1)},
+			result: "1.23456789 Ether",
+		},
+		{
+			name:   "DecimalsTrailingZeros",
+			input:  _bigInt("1500000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithDecimals(4), string2eth.WithTrailingZeros(true)},
+			result: "1.5000 Ether",
+		},
+		{
+			name:   "TrailingZeros",
+			input:  big.NewInt(1500000000),
+			opts:   []string2eth.FormatOption{string2eth.WithTrailingZeros(true)},
+			result: "1.500000000 GWei",
+		},
+		{
+			name:   "TrailingZerosWei",
+			input:  big.NewInt(15),
+			opts:   []string2eth.FormatOption{string2eth.WithTrailingZeros(true)},
+			result: "15 Wei",
+		},
+		{
+			name:   "TrailingZerosFalse",
+			input:  big.NewInt(1500000000),
+			opts:   []string2eth.FormatOption{string2eth.WithTrailingZeros(false)},
+			result: "1.5 GWei",
+		},
+		{
+			name:   "Grouping",
+			input:  _bigInt("1234567890000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithStandardUnits(), string2eth.WithGrouping(',')},
+			result: "1,234,567.89 Ether",
+		},
+		{
+			name:   "GroupingNegative",
+			input:  _bigInt("-1234000000000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithFixedUnit("ether"), string2eth.WithGrouping('_')},
+			result: "-1_234 Ether",
+		},
+		{
+			name:  "GroupingDecimalsFixedUnit",
+			input: _bigInt("1234567890000000000"),
+			opts: []string2eth.FormatOption{
+				string2eth.WithFixedUnit("gwei"),
+				string2eth.WithDecimals(2),
+				string2eth.WithTrailingZeros(true),
+				string2eth.WithGrouping(','),
+			},
+			result: "1,234,567,890.00 GWei",
+		},
+		{
+			name:  "AllOptions",
+			input: _bigInt("1234567890000000000000"),
+			opts: []string2eth.FormatOption{
+				string2eth.WithStandardUnits(),
+				string2eth.WithDecimals(3),
+				string2eth.WithTrailingZeros(true),
+				string2eth.WithGrouping(','),
+				string2eth.WithUnitFirst(),
+			},
+			result: "ETH 1,234.568",
+		},
+		{
+			name:   "UnitFirstStandard",
+			input:  _bigInt("1500000000000000"),
+			opts:   []string2eth.FormatOption{string2eth.WithStandardUnits(), string2eth.WithUnitFirst()},
+			result: "ETH 0.0015",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.WeiToStringOptions(test.input, test.opts...)
+			require.Equal(t, test.result, result)
+
+			// Exact unit-first output must parse back to the same value.
+			if test.input != nil && test.input.Sign() > 0 && test.result != "overflow" && test.result != "unknown unit" &&
+				!strings.HasPrefix(test.name, "Decimals") && test.name != "AllOptions" {
+				wei, err := string2eth.StringToWei(result)
+				require.NoError(t, err)
+				require.Equal(t, test.input, wei)
+			}
+		})
+	}
+}
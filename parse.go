@@ -0,0 +1,107 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Parse breaks a string down in to its parts without combining them in to a
+// number of Wei, allowing the caller to apply its own rounding policy.
+// It returns the integer part of the number, the digits after the decimal
+// point as an integer, the canonical name of the unit and its multiplier, e.g.
+// "1.024 ether" returns 1, 24, "Ether" and 10^18.  If no unit is supplied the
+// unit is "Wei".
+// Leading zeros in the fractional part are not retained, so "1.024" and
+// "1.24" have the same fractional part; use ParseWithScale to obtain the
+// number of digits after the decimal point as well.
+//
+// The input is a decimal number, optionally followed by a unit, for example
+// "1.5 ether", "1.5ether", ".5 finney" or "21 shannon", where:
+//   - the number may have a leading plus sign
+//   - the digits may be grouped with commas, spaces or underscores, e.g.
+//     "1,234.5 ETH"
+//   - the number may use scientific notation, e.g. "1.5e-2 gwei"
+//   - the ether symbol may precede or follow the number, e.g. "Ξ2.5"
+//
+// Negative values return ErrNegative.  Other forms accepted by StringToWei
+// return ErrInvalidFormat, including units other than the ether symbol before
+// the number, hexadecimal values, rational values and vulgar fractions,
+// parenthesised values and compound values such as "1 ether 500 finney".
+// Magnitude suffixes are not supported; they are taken to be part of the
+// unit, so "1.5k gwei" returns ErrUnknownUnit.
+func Parse(input string) (number *big.Int, fractional *big.Int, unit string, multiplier *big.Int, err error) {
+	number, fractional, _, unit, multiplier, err = ParseWithScale(input)
+
+	return number, fractional, unit, multiplier, err
+}
+
+// ParseWithScale is as per Parse, but also returns the scale of the
+// fractional part, being the number of digits after the decimal point, e.g.
+// "1.024 ether" returns 1, 24, 3, "Ether" and 10^18.  The scale includes
+// leading and trailing zeros, and is 0 if the number has no fractional part.
+func ParseWithScale(input string) (number *big.Int, fractional *big.Int, scale int, unit string, multiplier *big.Int, err error) {
+	input = normaliseWhitespace(input)
+	if strings.TrimSpace(input) == "" {
+		return nil, nil, 0, "", nil, ErrEmptyValue
+	}
+	if err := checkInputLength(input, DefaultMaxInputLength); err != nil {
+		return nil, nil, 0, "", nil, err
+	}
+	input = normalisePunctuation(normaliseDigits(input))
+	input = strings.ReplaceAll(input, " ", "")
+	input, err = removeUnderscores(input)
+	if err != nil {
+		return nil, nil, 0, "", nil, err
+	}
+	input = replaceEtherSymbol(input)
+	input, err = removeGrouping(input)
+	if err != nil {
+		return nil, nil, 0, "", nil, err
+	}
+
+	amount, units, ok := splitAmount(input)
+	if !ok {
+		return nil, nil, 0, "", nil, ErrInvalidFormat
+	}
+	if strings.HasPrefix(amount, "-") {
+		return nil, nil, 0, "", nil, ErrNegative
+	}
+	if strings.ContainsAny(amount, "eE") {
+		amount, err = expandExponent(amount)
+		if err != nil {
+			return nil, nil, 0, "", nil, err
+		}
+	}
+	multiplier, err = multiplierFor(units)
+	if err != nil {
+		return nil, nil, 0, "", nil, err
+	}
+	// This will never fail because the unit has already been resolved.
+	unitPos, _ := unitToMetricPos(units)
+
+	intPart, decPart, _ := strings.Cut(amount, ".")
+	number = new(big.Int)
+	if intPart != "" {
+		number.SetString(intPart, 10)
+	}
+	fractional = new(big.Int)
+	if decPart != "" {
+		fractional.SetString(decPart, 10)
+	}
+
+	return number, fractional, len(decPart), metricUnits[unitPos], multiplier, nil
+}
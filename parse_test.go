@@ -0,0 +1,204 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		number     string
+		fractional string
+		scale      int
+		unit       string
+		multiplier string
+		err        error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:       "Ether",
+			input:      "1.024 ether",
+			number:     "1",
+			fractional: "24",
+			scale:      3,
+			unit:       "Ether",
+			multiplier: "1000000000000000000",
+		},
+		{
+			name:       "LeadingZeros",
+			input:      "1.0024 ether",
+			number:     "1",
+			fractional: "24",
+			scale:      4,
+			unit:       "Ether",
+			multiplier: "1000000000000000000",
+		},
+		{
+			name:       "TrailingZeros",
+			input:      "1.240 ether",
+			number:     "1",
+			fractional: "240",
+			scale:      3,
+			unit:       "Ether",
+			multiplier: "1000000000000000000",
+		},
+		{
+			name:       "Integer",
+			input:      "21 shannon",
+			number:     "21",
+			fractional: "0",
+			scale:      0,
+			unit:       "GWei",
+			multiplier: "1000000000",
+		},
+		{
+			name:       "NoUnit",
+			input:      "1000",
+			number:     "1000",
+			fractional: "0",
+			scale:      0,
+			unit:       "Wei",
+			multiplier: "1",
+		},
+		{
+			name:       "NoInteger",
+			input:      ".5 finney",
+			number:     "0",
+			fractional: "5",
+			scale:      1,
+			unit:       "Milliether",
+			multiplier: "1000000000000000",
+		},
+		{
+			name:       "SubWei",
+			input:      "1.5 wei",
+			number:     "1",
+			fractional: "5",
+			scale:      1,
+			unit:       "Wei",
+			multiplier: "1",
+		},
+		{
+			name:       "Grouped",
+			input:      "1,234.5 ETH",
+			number:     "1234",
+			fractional: "5",
+			scale:      1,
+			unit:       "Ether",
+			multiplier: "1000000000000000000",
+		},
+		{
+			name:       "Exponent",
+			input:      "1.5e-2 gwei",
+			number:     "0",
+			fractional: "15",
+			scale:      3,
+			unit:       "GWei",
+			multiplier: "1000000000",
+		},
+		{
+			name:       "Symbol",
+			input:      "Ξ2.5",
+			number:     "2",
+			fractional: "5",
+			scale:      1,
+			unit:       "Ether",
+			multiplier: "1000000000000000000",
+		},
+		{
+			name:  "Negative",
+			input: "-1.5 ether",
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1.5 foo",
+			err:   string2eth.ErrUnknownUnit,
+		},
+		{
+			name:  "Hex",
+			input: "0x10 gwei",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "UnitBeforeNumber",
+			input: "ETH 1",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "MagnitudeSuffix",
+			input: "1.5k gwei",
+			err:   string2eth.ErrUnknownUnit,
+		},
+		{
+			name:  "Rational",
+			input: "1/4 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "VulgarFraction",
+			input: "½ ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Parentheses",
+			input: "(1 ether)",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Compound",
+			input: "1 ether 500 finney",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Invalid",
+			input: "1.2.3 ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			number, fractional, unit, multiplier, err := string2eth.Parse(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.number, number.Text(10))
+				require.Equal(t, test.fractional, fractional.Text(10))
+				require.Equal(t, test.unit, unit)
+				require.Equal(t, test.multiplier, multiplier.Text(10))
+			}
+
+			_, _, scale, _, _, err := string2eth.ParseWithScale(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.scale, scale)
+			}
+		})
+	}
+}
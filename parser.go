@@ -0,0 +1,176 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// parserOptions are the options for a Parser.
+type parserOptions struct {
+	allowedUnits   []string
+	maxInputLength int
+	maxValue       *big.Int
+}
+
+// ParserOption is an option for NewParser.
+type ParserOption func(*parserOptions)
+
+// WithAllowedUnits restricts the units that the parser will accept.  Any
+// name for an allowed unit is accepted, e.g. allowing "ether" also allows
+// "eth".  The empty unit is treated as "wei".
+// If no units are supplied all units are allowed.
+func WithAllowedUnits(units ...string) ParserOption {
+	return func(o *parserOptions) {
+		o.allowedUnits = append(o.allowedUnits, units...)
+	}
+}
+
+// WithMaxInputLength sets the maximum number of characters in an input,
+// excluding leading and trailing whitespace, beyond which the parser returns
+// ErrValueTooLong.  A length of 0 or less removes the limit, and should only
+// be used with trusted input.
+// If not supplied the limit is DefaultMaxInputLength.
+func WithMaxInputLength(length int) ParserOption {
+	return func(o *parserOptions) {
+		o.maxInputLength = length
+	}
+}
+
+// ApproximateEtherSupply is an approximation of the total supply of Ether, in
+// Wei, for use with WithMaxValue.  Any amount above this is certainly a
+// mistake.
+var ApproximateEtherSupply = new(big.Int).Mul(big.NewInt(121000000), big.NewInt(1000000000000000000)) // 121,000,000 Ether
+
+// WithMaxValue sets the maximum value, in Wei, that the parser will accept,
+// beyond which it returns ErrExceedsMaximum.  This guards against misplaced
+// units, e.g. "1000000 ether" instead of "1000000 gwei".
+// If not supplied, or nil, values are not capped.
+// The value is copied, so later changes to it have no effect.
+func WithMaxValue(maxValue *big.Int) ParserOption {
+	if maxValue != nil {
+		maxValue = new(big.Int).Set(maxValue)
+	}
+
+	return func(o *parserOptions) {
+		o.maxValue = maxValue
+	}
+}
+
+// Parser turns strings in to numbers of Wei, subject to its options.
+// A parser is safe for concurrent use.
+type Parser struct {
+	// allowedMultipliers are the multipliers of the allowed units; if nil
+	// all units are allowed.
+	allowedMultipliers map[string]struct{}
+	// maxInputLength is the maximum number of characters in an input.
+	maxInputLength int
+	// maxValue is the maximum value in Wei; if nil values are not capped.
+	maxValue *big.Int
+}
+
+// NewParser creates a new parser.
+// This returns ErrUnknownUnit if an allowed unit is not known.
+func NewParser(opts ...ParserOption) (*Parser, error) {
+	options := &parserOptions{
+		maxInputLength: DefaultMaxInputLength,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	parser := &Parser{
+		maxInputLength: options.maxInputLength,
+	}
+	if options.maxValue != nil {
+		parser.maxValue = new(big.Int).Set(options.maxValue)
+	}
+	if len(options.allowedUnits) > 0 {
+		parser.allowedMultipliers = make(map[string]struct{}, len(options.allowedUnits))
+		for _, unit := range options.allowedUnits {
+			multiplier, err := multiplierFor(unit)
+			if err != nil {
+				return nil, err
+			}
+			parser.allowedMultipliers[multiplier.Text(10)] = struct{}{}
+		}
+	}
+
+	return parser, nil
+}
+
+// ToWei turns a string in to number of Wei.
+// This returns ErrUnknownUnit if the string uses a unit that is not allowed,
+// and ErrExceedsMaximum if the value is above the maximum value.
+// See StringToWei for details.
+func (p *Parser) ToWei(input string) (*big.Int, error) {
+	result, err := p.toWei(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.maxValue != nil && result.Cmp(p.maxValue) > 0 {
+		return nil, wrapError(ErrExceedsMaximum, WeiToString(result, true)+" is above the maximum of "+WeiToString(p.maxValue, true))
+	}
+
+	return result, nil
+}
+
+// toWei turns a string in to number of Wei, subject to the allowed units.
+func (p *Parser) toWei(input string) (*big.Int, error) {
+	if p.allowedMultipliers == nil {
+		opts := newParseOptions(DefaultUnitResolver)
+		opts.maxLength = p.maxInputLength
+		result, _, err := stringToWei(input, opts)
+
+		return result, err
+	}
+
+	// The resolver is per-call so that the rejected unit is not shared.
+	var rejected bool
+	var rejectedUnit string
+	resolver := UnitResolverFunc(func(unit string) (*big.Int, error) {
+		multiplier, err := multiplierFor(unit)
+		if err != nil {
+			return nil, err
+		}
+		if _, allowed := p.allowedMultipliers[multiplier.Text(10)]; !allowed {
+			rejected = true
+			rejectedUnit = unit
+			if rejectedUnit == "" {
+				rejectedUnit = "wei"
+			}
+
+			return nil, wrapErrorValue(ErrUnknownUnit, unit)
+		}
+
+		return multiplier, nil
+	})
+
+	opts := newParseOptions(resolver)
+	opts.maxLength = p.maxInputLength
+	result, _, err := stringToWei(input, opts)
+	if err == nil {
+		// Parsing may try units that are not used in the result, for
+		// example when finding the end of a hexadecimal number, so a
+		// rejection only matters if parsing failed.
+		return result, nil
+	}
+	if rejected {
+		return nil, wrapErrorValue(ErrUnknownUnit, rejectedUnit)
+	}
+
+	return nil, err
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestNewParser(t *testing.T) {
+	_, err := string2eth.NewParser(string2eth.WithAllowedUnits("gwei", "foo"))
+	require.EqualError(t, err, "unknown unit foo")
+}
+
+func TestParserToWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []string2eth.ParserOption
+		input  string
+		result *big.Int
+		err    string
+	}{
+		{
+			name:   "AllUnits",
+			input:  "5 finney",
+			result: big.NewInt(5000000000000000),
+		},
+		{
+			name:   "EmptyWhitelist",
+			opts:   []string2eth.ParserOption{string2eth.WithAllowedUnits()},
+			input:  "200 kwei",
+			result: big.NewInt(200000),
+		},
+		{
+			name:   "Allowed",
+			opts:   []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input:  "21 gwei",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "AllowedAlias",
+			opts:   []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input:  "1.5 ETH",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:   "AllowedNoUnit",
+			opts:   []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input:  "1000",
+			result: big.NewInt(1000),
+		},
+		{
+			// Units tried as part of reading the hexadecimal number, such as
+			// "" (wei) in "1ada", must not cause the input to be rejected.
+			name:   "AllowedHex",
+			opts:   []string2eth.ParserOption{string2eth.WithAllowedUnits("ada")},
+			input:  "0x1 ada",
+			result: big.NewInt(1000),
+		},
+		{
+			name:  "DisallowedHex",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei")},
+			input: "0x10",
+			err:   "unknown unit wei",
+		},
+		{
+			name:  "Disallowed",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input: "5 finney",
+			err:   "unknown unit finney",
+		},
+		{
+			name:  "DisallowedDecimal",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input: "200.5 kwei",
+			err:   "unknown unit kwei",
+		},
+		{
+			name:  "DisallowedNoUnit",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether")},
+			input: "1000",
+			err:   "unknown unit wei",
+		},
+		{
+			name:  "DisallowedCompound",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei", "ether", "wei")},
+			input: "1 ether 500 finney",
+			err:   "unknown unit finney",
+		},
+		{
+			name:  "Invalid",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei")},
+			input: "1 foo",
+			err:   `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+		{
+			name:  "DefaultTooLong",
+			input: strings.Repeat("1", 257),
+			err:   "value too long: more than 256 characters",
+		},
+		{
+			name:   "MaxInputLength",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxInputLength(8)},
+			input:  "1.5 gwei",
+			result: big.NewInt(1500000000),
+		},
+		{
+			name:  "MaxInputLengthExceeded",
+			opts:  []string2eth.ParserOption{string2eth.WithMaxInputLength(8)},
+			input: "1.25 gwei",
+			err:   "value too long: more than 8 characters",
+		},
+		{
+			name:   "MaxInputLengthIncreased",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxInputLength(1024)},
+			input:  "1" + strings.Repeat("0", 299),
+			result: _bigInt("1" + strings.Repeat("0", 299)),
+		},
+		{
+			name:   "MaxInputLengthUnlimited",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxInputLength(0)},
+			input:  "1" + strings.Repeat("0", 299),
+			result: _bigInt("1" + strings.Repeat("0", 299)),
+		},
+		{
+			name:   "MaxValue",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxValue(string2eth.ApproximateEtherSupply)},
+			input:  "1000000 gwei",
+			result: big.NewInt(1000000000000000),
+		},
+		{
+			name:   "MaxValueEqual",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxValue(big.NewInt(1000))},
+			input:  "1 kwei",
+			result: big.NewInt(1000),
+		},
+		{
+			name:  "MaxValueExceeded",
+			opts:  []string2eth.ParserOption{string2eth.WithMaxValue(string2eth.ApproximateEtherSupply)},
+			input: "1000000000 ether",
+			err:   "value exceeds maximum: 1000000000 Ether is above the maximum of 121000000 Ether",
+		},
+		{
+			name:  "MaxValueExceededAllowedUnits",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei"), string2eth.WithMaxValue(big.NewInt(1000000000))},
+			input: "1.5 gwei",
+			err:   "value exceeds maximum: 1.5 GWei is above the maximum of 1 GWei",
+		},
+		{
+			name:   "MaxValueNil",
+			opts:   []string2eth.ParserOption{string2eth.WithMaxValue(nil)},
+			input:  "1000000000 ether",
+			result: _bigInt("1000000000000000000000000000"),
+		},
+		{
+			name:   "Uncapped",
+			input:  "1000000000 ether",
+			result: _bigInt("1000000000000000000000000000"),
+		},
+		{
+			name:  "MaxInputLengthAllowedUnits",
+			opts:  []string2eth.ParserOption{string2eth.WithAllowedUnits("gwei"), string2eth.WithMaxInputLength(8)},
+			input: "1.25 gwei",
+			err:   "value too long: more than 8 characters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser, err := string2eth.NewParser(test.opts...)
+			require.NoError(t, err)
+			result, err := parser.ToWei(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestParserConcurrent(t *testing.T) {
+	parser, err := string2eth.NewParser(string2eth.WithAllowedUnits("gwei"))
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				result, err := parser.ToWei("21 gwei")
+				require.NoError(t, err)
+				require.Equal(t, big.NewInt(21000000000), result)
+			} else {
+				_, err := parser.ToWei("21 finney")
+				require.ErrorIs(t, err, string2eth.ErrUnknownUnit)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestParserMaxValueCopies(t *testing.T) {
+	maxValue := big.NewInt(1000000000000000000)
+	opt := string2eth.WithMaxValue(maxValue)
+
+	// Changes to the argument after the option is created have no effect.
+	maxValue.SetInt64(1)
+	parser, err := string2eth.NewParser(opt)
+	require.NoError(t, err)
+	result, err := parser.ToWei("1 ether")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000000000000000000), result)
+}
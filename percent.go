@@ -0,0 +1,68 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// hundred and tenThousand are the denominators for percentages and basis
+// points.
+var (
+	hundred     = big.NewInt(100)
+	tenThousand = big.NewInt(10000)
+)
+
+// PercentOf returns the given percentage of a number of Wei, e.g. 1.5% of
+// 1 Ether is 15000000000000000 Wei.
+// The percentage is used as its shortest decimal representation, e.g. 0.1
+// rather than the nearest binary floating point value, so there is no
+// floating point drift regardless of the size of the value.
+// The result is rounded to the nearest Wei, with ties away from zero.  A nil
+// value is treated as zero.  If the percentage is not finite this returns
+// nil.
+func PercentOf(wei *big.Int, percent float64) *big.Int {
+	if math.IsNaN(percent) || math.IsInf(percent, 0) {
+		return nil
+	}
+	if wei == nil {
+		return new(big.Int)
+	}
+
+	// This will never fail as the percentage is finite.
+	fraction, _ := new(big.Rat).SetString(strconv.FormatFloat(percent, 'f', -1, 64))
+
+	numerator := new(big.Int).Mul(wei, fraction.Num())
+	denominator := new(big.Int).Mul(fraction.Denom(), hundred)
+
+	return divRound(numerator, denominator, RoundHalfUp)
+}
+
+// BasisPointsOf returns the given number of basis points, i.e. hundredths of
+// a percent, of a number of Wei, e.g. 150 basis points of 1 Ether is
+// 15000000000000000 Wei.
+// The result is rounded to the nearest Wei, with ties away from zero.  A nil
+// value is treated as zero.
+func BasisPointsOf(wei *big.Int, bps int) *big.Int {
+	if wei == nil {
+		return new(big.Int)
+	}
+
+	numerator := new(big.Int).Mul(wei, big.NewInt(int64(bps)))
+
+	return divRound(numerator, tenThousand, RoundHalfUp)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestPercentOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *big.Int
+		percent float64
+		result  string
+	}{
+		{
+			name:    "Nil",
+			input:   nil,
+			percent: 10,
+			result:  "0",
+		},
+		{
+			name:    "Zero",
+			input:   big.NewInt(0),
+			percent: 10,
+			result:  "0",
+		},
+		{
+			name:    "Whole",
+			input:   _bigInt("1000000000000000000"),
+			percent: 10,
+			result:  "100000000000000000",
+		},
+		{
+			name:    "Fractional",
+			input:   _bigInt("1000000000000000000"),
+			percent: 1.5,
+			result:  "15000000000000000",
+		},
+		{
+			name:    "NoDrift",
+			input:   _bigInt("1000000000000000000000000000000"),
+			percent: 0.1,
+			result:  "1000000000000000000000000000",
+		},
+		{
+			name:    "AboveInt64",
+			input:   _bigInt("123456789012345678901234567890"),
+			percent: 33,
+			result:  "40740740374074074037407407404",
+		},
+		{
+			name:    "MoreThanWhole",
+			input:   big.NewInt(1000),
+			percent: 250,
+			result:  "2500",
+		},
+		{
+			name:    "RoundDown",
+			input:   big.NewInt(1),
+			percent: 49,
+			result:  "0",
+		},
+		{
+			name:    "TieAwayFromZero",
+			input:   big.NewInt(1),
+			percent: 50,
+			result:  "1",
+		},
+		{
+			name:    "NegativeTieAwayFromZero",
+			input:   big.NewInt(-1),
+			percent: 50,
+			result:  "-1",
+		},
+		{
+			name:    "NegativePercent",
+			input:   big.NewInt(1000),
+			percent: -12.5,
+			result:  "-125",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.PercentOf(test.input, test.percent)
+			require.Equal(t, test.result, result.Text(10))
+		})
+	}
+}
+
+func TestPercentOfNotFinite(t *testing.T) {
+	require.Nil(t, string2eth.PercentOf(big.NewInt(1), math.NaN()))
+	require.Nil(t, string2eth.PercentOf(big.NewInt(1), math.Inf(1)))
+	require.Nil(t, string2eth.PercentOf(big.NewInt(1), math.Inf(-1)))
+}
+
+func TestBasisPointsOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		bps    int
+		result string
+	}{
+		{
+			name:   "Nil",
+			input:  nil,
+			bps:    150,
+			result: "0",
+		},
+		{
+			name:   "Ether",
+			input:  _bigInt("1000000000000000000"),
+			bps:    150,
+			result: "15000000000000000",
+		},
+		{
+			name:   "AboveInt64",
+			input:  _bigInt("123456789012345678901234567890"),
+			bps:    1,
+			result: "12345678901234567890123457",
+		},
+		{
+			name:   "Whole",
+			input:  big.NewInt(12345),
+			bps:    10000,
+			result: "12345",
+		},
+		{
+			name:   "TieAwayFromZero",
+			input:  big.NewInt(1),
+			bps:    5000,
+			result: "1",
+		},
+		{
+			name:   "NegativeTieAwayFromZero",
+			input:  big.NewInt(-1),
+			bps:    5000,
+			result: "-1",
+		},
+		{
+			name:   "RoundDown",
+			input:  big.NewInt(1),
+			bps:    4999,
+			result: "0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := string2eth.BasisPointsOf(test.input, test.bps)
+			require.Equal(t, test.result, result.Text(10))
+		})
+	}
+}
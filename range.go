@@ -0,0 +1,90 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+)
+
+// rangeSeparators are the separators accepted between the bounds of a range.
+// "to" must be surrounded by spaces to avoid matching units such as "atto".
+var rangeSeparators = []string{"-", "\u2013", "\u2014", "..", " to "}
+
+// ParseRange turns a range string in to minimum and maximum numbers of Wei.
+// The bounds are separated by a hyphen, an en dash, an em dash, ".." or "to",
+// e.g. "1-2 gwei", "0.5–1 ether", "1..2 gwei" or "1 to 2 gwei".
+// The range can either have a unit on each bound, e.g. "1 ether - 5 ether",
+// or a single unit that applies to both bounds, e.g. "1-5 ether" or
+// "1 ether - 5".
+// The minimum must not be greater than the maximum.
+// See StringToWei for details of each bound.
+func ParseRange(input string) (*big.Int, *big.Int, error) {
+	if input == "" {
+		return nil, nil, ErrEmptyValue
+	}
+	if err := checkInputLength(input, DefaultMaxInputLength); err != nil {
+		return nil, nil, err
+	}
+
+	// As a hyphen can also appear within a bound, e.g. "1e-3", try each
+	// separator in turn.
+	for i := 1; i < len(input); i++ {
+		for _, separator := range rangeSeparators {
+			if !strings.HasPrefix(input[i:], separator) {
+				continue
+			}
+			minimum, maximum, err := parseRangeBounds(input[:i], input[i+len(separator):])
+			if err != nil {
+				continue
+			}
+			if minimum.Cmp(maximum) > 0 {
+				return nil, nil, wrapError(ErrInvalidRange, input)
+			}
+
+			return minimum, maximum, nil
+		}
+	}
+
+	return nil, nil, wrapError(ErrInvalidFormat, "invalid range "+input)
+}
+
+// parseRangeBounds parses the minimum and maximum bounds of a range.
+func parseRangeBounds(minInput string, maxInput string) (*big.Int, *big.Int, error) {
+	maximum, maxUnit, err := stringToWei(maxInput, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	minInput = strings.TrimSpace(minInput)
+	if minInput != "" && (isDigit(minInput[len(minInput)-1]) || minInput[len(minInput)-1] == '.') {
+		// Apply the unit of the maximum to the minimum.
+		minInput += maxUnit
+	}
+	minimum, minUnit, err := stringToWei(minInput, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if maxUnit == "" && minUnit != "" {
+		// Apply the unit of the minimum to the maximum.
+		maximum, _, err = stringToWei(strings.TrimSpace(maxInput)+minUnit, newParseOptions(DefaultUnitResolver))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return minimum, maximum, nil
+}
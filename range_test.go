@@ -0,0 +1,181 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		minimum *big.Int
+		maximum *big.Int
+		err     string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:    "SharedUnit",
+			input:   "1-5 ether",
+			minimum: _bigInt("1000000000000000000"),
+			maximum: _bigInt("5000000000000000000"),
+		},
+		{
+			name:    "SharedUnitSpaced",
+			input:   "0.5 - 1.5 gwei",
+			minimum: big.NewInt(500000000),
+			maximum: big.NewInt(1500000000),
+		},
+		{
+			name:    "PerBoundUnit",
+			input:   "1 ether - 5 ether",
+			minimum: _bigInt("1000000000000000000"),
+			maximum: _bigInt("5000000000000000000"),
+		},
+		{
+			name:    "DifferentUnits",
+			input:   "500 finney-2 ether",
+			minimum: _bigInt("500000000000000000"),
+			maximum: _bigInt("2000000000000000000"),
+		},
+		{
+			name:    "NoUnits",
+			input:   "1000-2000",
+			minimum: big.NewInt(1000),
+			maximum: big.NewInt(2000),
+		},
+		{
+			name:    "Exponent",
+			input:   "1e-3-5e-3 ether",
+			minimum: _bigInt("1000000000000000"),
+			maximum: _bigInt("5000000000000000"),
+		},
+		{
+			name:    "Equal",
+			input:   "1-1 ether",
+			minimum: _bigInt("1000000000000000000"),
+			maximum: _bigInt("1000000000000000000"),
+		},
+		{
+			name:    "EnDash",
+			input:   "0.5–1 ether",
+			minimum: _bigInt("500000000000000000"),
+			maximum: _bigInt("1000000000000000000"),
+		},
+		{
+			name:    "EmDash",
+			input:   "1 — 2 gwei",
+			minimum: big.NewInt(1000000000),
+			maximum: big.NewInt(2000000000),
+		},
+		{
+			name:    "Dots",
+			input:   "1..2 gwei",
+			minimum: big.NewInt(1000000000),
+			maximum: big.NewInt(2000000000),
+		},
+		{
+			name:    "DotsDecimal",
+			input:   "1.5..2.5 gwei",
+			minimum: big.NewInt(1500000000),
+			maximum: big.NewInt(2500000000),
+		},
+		{
+			name:    "To",
+			input:   "1 to 2 gwei",
+			minimum: big.NewInt(1000000000),
+			maximum: big.NewInt(2000000000),
+		},
+		{
+			name:    "ToAtto",
+			input:   "1 atto to 2 atto",
+			minimum: big.NewInt(1),
+			maximum: big.NewInt(2),
+		},
+		{
+			name:    "UnitOnMinimum",
+			input:   "1 gwei - 2",
+			minimum: big.NewInt(1000000000),
+			maximum: big.NewInt(2000000000),
+		},
+		{
+			name:  "EnDashReversed",
+			input: "2–1 ether",
+			err:   "range minimum is greater than maximum: 2–1 ether",
+		},
+		{
+			name:  "NegativeMinimum",
+			input: "-1-2 gwei",
+			err:   "invalid format: invalid range -1-2 gwei",
+		},
+		{
+			name:  "MixedSeparators",
+			input: "1-2 to 3 gwei",
+			err:   "invalid format: invalid range 1-2 to 3 gwei",
+		},
+		{
+			name:  "ToWithoutSpaces",
+			input: "1to2 gwei",
+			err:   "invalid format: invalid range 1to2 gwei",
+		},
+		{
+			name:  "MinimumGreaterThanMaximum",
+			input: "5-1 ether",
+			err:   "range minimum is greater than maximum: 5-1 ether",
+		},
+		{
+			name:  "MixedUnitsMinimumGreater",
+			input: "2 ether - 500 finney",
+			err:   "range minimum is greater than maximum: 2 ether - 500 finney",
+		},
+		{
+			name:  "NoSeparator",
+			input: "5 ether",
+			err:   "invalid format: invalid range 5 ether",
+		},
+		{
+			name:  "MissingMaximum",
+			input: "5-",
+			err:   "invalid format: invalid range 5-",
+		},
+		{
+			name:  "InvalidBound",
+			input: "1 foo - 5 ether",
+			err:   "invalid format: invalid range 1 foo - 5 ether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			minimum, maximum, err := string2eth.ParseRange(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.minimum, minimum)
+				require.Equal(t, test.maximum, maximum)
+			}
+		})
+	}
+}
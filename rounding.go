@@ -0,0 +1,108 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import "math/big"
+
+// RoundingMode defines how values are rounded when precision is lost.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest value, with halves rounded away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest value, with halves rounded to the even value.
+	RoundHalfEven
+	// RoundTowardZero truncates, discarding the lost precision.
+	RoundTowardZero
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+// RoundDown truncates, discarding the lost precision.  It is the same as
+// RoundTowardZero.
+const RoundDown = RoundTowardZero
+
+// RoundWei rounds a number of Wei to a whole number of the given unit, as per
+// the rounding mode, e.g. 1500000000 Wei rounded half up to "gwei" is
+// 2000000000 Wei.
+// The input is not changed.
+func RoundWei(input *big.Int, unit string, mode RoundingMode) (*big.Int, error) {
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return nil, err
+	}
+	if input == nil {
+		return new(big.Int), nil
+	}
+
+	result := divRound(input, multiplier, mode)
+
+	return result.Mul(result, multiplier), nil
+}
+
+// divWei divides the numerator by the positive denominator to obtain a number
+// of Wei.  If the result is fractional it is rounded if the options have a
+// rounding mode, otherwise ErrFractional is returned.
+func divWei(numerator *big.Int, denominator *big.Int, opts *parseOptions) (*big.Int, error) {
+	if opts.rounding {
+		return divRound(numerator, denominator, opts.mode), nil
+	}
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, ErrFractional
+	}
+
+	return quotient, nil
+}
+
+// divRound divides the numerator by the positive denominator, rounding the
+// result as per the rounding mode.
+func divRound(numerator *big.Int, denominator *big.Int, mode RoundingMode) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(new(big.Int).Abs(numerator), denominator, new(big.Int))
+	if remainder.Sign() != 0 {
+		cmp := new(big.Int).Lsh(remainder, 1).Cmp(denominator)
+		switch mode {
+		case RoundHalfUp:
+			if cmp >= 0 {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		case RoundHalfEven:
+			if cmp > 0 || (cmp == 0 && quotient.Bit(0) == 1) {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		case RoundUp:
+			quotient.Add(quotient, big.NewInt(1))
+		case RoundCeiling:
+			if numerator.Sign() > 0 {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		case RoundFloor:
+			if numerator.Sign() < 0 {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		case RoundTowardZero:
+		}
+	}
+	if numerator.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+
+	return quotient
+}
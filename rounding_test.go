@@ -0,0 +1,185 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestRoundWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		unit   string
+		mode   string2eth.RoundingMode
+		result *big.Int
+		err    error
+	}{
+		{
+			name:  "UnknownUnit",
+			input: big.NewInt(1),
+			unit:  "foo",
+			mode:  string2eth.RoundHalfUp,
+			err:   string2eth.ErrUnknownUnit,
+		},
+		{
+			name:   "Nil",
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(0),
+		},
+		{
+			name:   "Exact",
+			input:  big.NewInt(2000000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundCeiling,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "HalfUpHalf",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "HalfUpNegativeHalf",
+			input:  big.NewInt(-1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(-2000000000),
+		},
+		{
+			name:   "HalfUpBelowHalf",
+			input:  big.NewInt(1499999999),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfUp,
+			result: big.NewInt(1000000000),
+		},
+		{
+			name:   "HalfEvenHalfDown",
+			input:  big.NewInt(2500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "HalfEvenHalfUp",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "HalfEvenAboveHalf",
+			input:  big.NewInt(2500000001),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(3000000000),
+		},
+		{
+			name:   "CeilingHalf",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundCeiling,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "CeilingNegativeHalf",
+			input:  big.NewInt(-1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundCeiling,
+			result: big.NewInt(-1000000000),
+		},
+		{
+			name:   "CeilingSmall",
+			input:  big.NewInt(1),
+			unit:   "ether",
+			mode:   string2eth.RoundCeiling,
+			result: _bigInt("1000000000000000000"),
+		},
+		{
+			name:   "FloorHalf",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundFloor,
+			result: big.NewInt(1000000000),
+		},
+		{
+			name:   "FloorNegativeHalf",
+			input:  big.NewInt(-1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundFloor,
+			result: big.NewInt(-2000000000),
+		},
+		{
+			name:   "TowardZeroHalf",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundTowardZero,
+			result: big.NewInt(1000000000),
+		},
+		{
+			name:   "TowardZeroNegativeHalf",
+			input:  big.NewInt(-1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundTowardZero,
+			result: big.NewInt(-1000000000),
+		},
+		{
+			name:   "UpHalf",
+			input:  big.NewInt(1500000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundUp,
+			result: big.NewInt(2000000000),
+		},
+		{
+			name:   "Ether",
+			input:  _bigInt("1499999999999999999"),
+			unit:   "ether",
+			mode:   string2eth.RoundHalfUp,
+			result: _bigInt("1000000000000000000"),
+		},
+		{
+			name:   "ToZero",
+			input:  big.NewInt(400000000),
+			unit:   "gwei",
+			mode:   string2eth.RoundHalfEven,
+			result: big.NewInt(0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var input *big.Int
+			if test.input != nil {
+				input = new(big.Int).Set(test.input)
+			}
+			result, err := string2eth.RoundWei(input, test.unit, test.mode)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result.Text(10), result.Text(10))
+				// The input must not be changed.
+				require.Equal(t, test.input, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+// scanAmount separates an input string in to its numeric and unit parts
+// without the use of regular expressions.
+// The numeric part is an optional leading '-', followed by digits with an
+// optional decimal point and an optional exponent; the unit part is any
+// trailing ASCII letters.  The numeric part must contain at least one digit.
+// The final return value is false if the input does not follow this format.
+func scanAmount(input string) (string, string, bool) {
+	pos := 0
+	if pos < len(input) && input[pos] == '-' {
+		pos++
+	}
+	digits := 0
+	for pos < len(input) && isDigit(input[pos]) {
+		pos++
+		digits++
+	}
+	if pos < len(input) && input[pos] == '.' {
+		pos++
+		for pos < len(input) && isDigit(input[pos]) {
+			pos++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return "", "", false
+	}
+	if pos < len(input) && (input[pos] == 'e' || input[pos] == 'E') {
+		// Only an exponent if followed by an optional sign and at least one digit.
+		expPos := pos + 1
+		if expPos < len(input) && (input[expPos] == '+' || input[expPos] == '-') {
+			expPos++
+		}
+		if expPos < len(input) && isDigit(input[expPos]) {
+			pos = expPos
+			for pos < len(input) && isDigit(input[pos]) {
+				pos++
+			}
+		}
+	}
+	numberEnd := pos
+	for pos < len(input) && isLetter(input[pos]) {
+		pos++
+	}
+	if pos != len(input) {
+		return "", "", false
+	}
+
+	return input[:numberEnd], input[numberEnd:], true
+}
+
+// scanCompound separates an input string in to number and unit pairs, e.g.
+// "1ether500finney" in to "1ether" and "500finney".
+// Each pair must have a number of digits with an optional decimal point, and
+// a unit of ASCII letters.
+// The final return value is false if the input does not follow this format.
+func scanCompound(input string) ([]string, bool) {
+	pairs := make([]string, 0)
+	pos := 0
+	for pos < len(input) {
+		start := pos
+		for pos < len(input) && (isDigit(input[pos]) || input[pos] == '.') {
+			pos++
+		}
+		if pos == start {
+			return nil, false
+		}
+		unitStart := pos
+		for pos < len(input) && isLetter(input[pos]) {
+			pos++
+		}
+		if pos == unitStart {
+			return nil, false
+		}
+		pairs = append(pairs, input[start:pos])
+	}
+
+	return pairs, len(pairs) > 0
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
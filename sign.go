@@ -0,0 +1,35 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// IsZero returns true if the number of Wei is zero.  A nil value is treated
+// as zero, as per WeiToString.
+func IsZero(wei *big.Int) bool {
+	return wei == nil || wei.Sign() == 0
+}
+
+// Sign returns -1, 0 or 1 depending on whether the number of Wei is negative,
+// zero or positive.  A nil value is treated as zero, as per WeiToString.
+func Sign(wei *big.Int) int {
+	if wei == nil {
+		return 0
+	}
+
+	return wei.Sign()
+}
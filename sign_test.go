@@ -0,0 +1,85 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		isZero bool
+		sign   int
+	}{
+		{
+			name:   "Nil",
+			input:  nil,
+			isZero: true,
+			sign:   0,
+		},
+		{
+			name:   "Zero",
+			input:  big.NewInt(0),
+			isZero: true,
+			sign:   0,
+		},
+		{
+			name:   "ZeroValue",
+			input:  new(big.Int),
+			isZero: true,
+			sign:   0,
+		},
+		{
+			name:  "Positive",
+			input: big.NewInt(1),
+			sign:  1,
+		},
+		{
+			name:  "Large",
+			input: _bigInt("1000000000000000000000000000000000"),
+			sign:  1,
+		},
+		{
+			name:  "Negative",
+			input: big.NewInt(-1),
+			sign:  -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.isZero, string2eth.IsZero(test.input))
+			require.Equal(t, test.sign, string2eth.Sign(test.input))
+		})
+	}
+}
+
+func TestSignParsed(t *testing.T) {
+	wei, err := string2eth.StringToWeiSigned("-1.5 ether")
+	require.NoError(t, err)
+	require.Equal(t, -1, string2eth.Sign(wei))
+	require.False(t, string2eth.IsZero(wei))
+
+	wei, err = string2eth.StringToWei("0 ether")
+	require.NoError(t, err)
+	require.Equal(t, 0, string2eth.Sign(wei))
+	require.True(t, string2eth.IsZero(wei))
+}
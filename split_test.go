@@ -0,0 +1,99 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanAmountMatchesSplitAmount(t *testing.T) {
+	inputs := []string{
+		"",
+		"1",
+		"-1",
+		"-",
+		".",
+		"-.",
+		"1.",
+		".1",
+		"-0.5",
+		"1.2.3",
+		"1ether",
+		"1.5Ether",
+		"ether",
+		"-ether",
+		"1e",
+		"1e18",
+		"1E18",
+		"1e+18",
+		"1e-3ether",
+		"1.5e9gwei",
+		"1e+",
+		"1e-",
+		"e5",
+		"-e5",
+		".e5",
+		"1e5e5",
+		"1eether",
+		"1ether500finney",
+		"1ether1",
+		"0x10",
+		"1,000",
+		"1@",
+		"@",
+		"1-",
+		"--1",
+		"1..2",
+		"1.5.gwei",
+		"1µether",
+		".ether",
+		"-.ether",
+		"-ether5",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			number, unit, ok := scanAmount(input)
+			expectedNumber, expectedUnit, expectedOK := splitAmount(input)
+			require.Equal(t, expectedOK, ok)
+			require.Equal(t, expectedNumber, number)
+			require.Equal(t, expectedUnit, unit)
+		})
+	}
+}
+
+// TestMinimalBuild runs the full test suite with the minimal build tag, to
+// ensure that the minimal build behaves identically to the standard build.
+func TestMinimalBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping minimal build test in short mode")
+	}
+	if os.Getenv("STRING2ETH_TINY") != "" {
+		t.Skip("already running minimal build test")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go binary not available")
+	}
+
+	cmd := exec.Command(goBin, "test", "-count=1", "-tags", "string2eth_tiny", ".")
+	cmd.Env = append(os.Environ(), "STRING2ETH_TINY=1")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"unicode"
+)
+
+// StringToWeiStrict turns a string in to number of Wei, accepting only
+// well-formed input.  It is intended for validating input such as
+// configuration files, where a typing error should be reported rather than
+// silently corrected.
+//
+// The input must be of the form "[-]digits[.digits] unit", for example
+// "21 gwei" or "0.5 ether", where:
+//   - the unit is required, e.g. "1000 wei" rather than "1000"
+//   - the number and unit are separated by exactly one space
+//   - the digits are ASCII, without underscores or grouping separators
+//   - the number does not start or end with a decimal point, e.g. ".5" or "5."
+//
+// Forms accepted by StringToWei such as leading plus signs, exponents,
+// fractions, magnitude suffixes, hexadecimal values and units before the number
+// are rejected.  Errors state the rule that was violated.
+func StringToWeiStrict(input string) (*big.Int, error) {
+	if err := checkInputLength(input, DefaultMaxInputLength); err != nil {
+		return nil, err
+	}
+	number, unit, err := checkStrict(input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(big.Int)
+	if strings.Contains(number, ".") {
+		err = decimalStringToWei(number, unit, newParseOptions(DefaultUnitResolver), result)
+	} else {
+		err = integerStringToWei(number, unit, DefaultUnitResolver, result)
+	}
+	if err != nil {
+		var parseErr *ParseError
+		if errors.As(err, &parseErr) {
+			parseErr.locate(input)
+		}
+
+		return nil, err
+	}
+	if result.Sign() < 0 {
+		return nil, ErrNegative
+	}
+
+	return result, nil
+}
+
+// checkStrict checks that the input meets the requirements of
+// StringToWeiStrict, returning the number and unit.
+func checkStrict(input string) (string, string, error) {
+	if input == "" {
+		return "", "", ErrEmptyValue
+	}
+	if strings.Contains(input, "_") {
+		return "", "", wrapError(ErrInvalidFormat, "underscores not permitted")
+	}
+	if strings.TrimSpace(input) != input {
+		return "", "", wrapError(ErrInvalidFormat, "leading or trailing whitespace not permitted")
+	}
+
+	number, unit, found := strings.Cut(input, " ")
+	if !found {
+		if _, units, ok := splitAmount(input); (ok && units != "") || strings.IndexFunc(input, unicode.IsSpace) != -1 {
+			return "", "", wrapError(ErrInvalidFormat, "a single space is required between number and unit")
+		}
+
+		return "", "", wrapError(ErrInvalidFormat, "unit is required")
+	}
+	if strings.IndexFunc(number, unicode.IsSpace) != -1 || strings.IndexFunc(unit, unicode.IsSpace) != -1 {
+		return "", "", wrapError(ErrInvalidFormat, "a single space is required between number and unit")
+	}
+	if err := checkStrictNumber(number, unit); err != nil {
+		return "", "", err
+	}
+	if strings.IndexFunc(unit, func(r rune) bool { return !unicode.IsLetter(r) }) != -1 {
+		return "", "", wrapError(ErrInvalidFormat, "unit must contain only letters")
+	}
+
+	return number, unit, nil
+}
+
+// checkStrictNumber checks that the number meets the requirements of
+// StringToWeiStrict.
+func checkStrictNumber(number string, unit string) error {
+	if strings.HasPrefix(number, "+") {
+		return wrapError(ErrInvalidFormat, "leading plus sign not permitted")
+	}
+	digits := strings.TrimPrefix(number, "-")
+	if strings.HasPrefix(strings.ToLower(digits), "0x") {
+		return wrapError(ErrInvalidFormat, "hexadecimal values not permitted")
+	}
+	if strings.IndexFunc(digits, unicode.IsDigit) == -1 && strings.IndexFunc(unit, unicode.IsDigit) != -1 {
+		return wrapError(ErrInvalidFormat, "unit must follow the number")
+	}
+
+	for _, r := range digits {
+		switch {
+		case r >= '0' && r <= '9', r == '.':
+		case unicode.IsDigit(r):
+			return wrapError(ErrInvalidFormat, "digits must be ASCII")
+		case r == ',' || r == '\'' || r == '\u2019':
+			return wrapError(ErrInvalidFormat, "digit grouping not permitted")
+		case r == 'e' || r == 'E':
+			return wrapError(ErrInvalidFormat, "exponents not permitted")
+		case r == '/' || r == '\u2044':
+			return wrapError(ErrInvalidFormat, "rational values not permitted")
+		case unicode.Is(unicode.No, r):
+			return wrapError(ErrInvalidFormat, "vulgar fractions not permitted")
+		case unicode.IsLetter(r):
+			return wrapError(ErrInvalidFormat, "magnitude suffixes not permitted")
+		default:
+			return wrapError(ErrInvalidFormat, "number must be digits with an optional decimal point")
+		}
+	}
+	if strings.Count(digits, ".") > 1 {
+		return wrapError(ErrInvalidFormat, "number must be digits with an optional decimal point")
+	}
+	if digits == "" {
+		return wrapError(ErrInvalidFormat, "number is required")
+	}
+	if strings.HasPrefix(digits, ".") {
+		return wrapError(ErrInvalidFormat, "a leading decimal point requires a zero")
+	}
+	if strings.HasSuffix(digits, ".") {
+		return wrapError(ErrInvalidFormat, "trailing decimal point not permitted")
+	}
+
+	return nil
+}
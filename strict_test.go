@@ -0,0 +1,230 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestStringToWeiStrict(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result *big.Int
+		err    string
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   "failed to parse empty value",
+		},
+		{
+			name:   "Integer",
+			input:  "21 gwei",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "Decimal",
+			input:  "0.5 ether",
+			result: big.NewInt(500000000000000000),
+		},
+		{
+			name:  "Hex",
+			input: "0x10 gwei",
+			err:   "invalid format: hexadecimal values not permitted",
+		},
+		{
+			name:  "LeadingPlus",
+			input: "+1 ether",
+			err:   "invalid format: leading plus sign not permitted",
+		},
+		{
+			name:  "Grouping",
+			input: "1,000 ether",
+			err:   "invalid format: digit grouping not permitted",
+		},
+		{
+			name:  "Exponent",
+			input: "1e3 ether",
+			err:   "invalid format: exponents not permitted",
+		},
+		{
+			name:  "VulgarFraction",
+			input: "1½ ether",
+			err:   "invalid format: vulgar fractions not permitted",
+		},
+		{
+			name:  "Rational",
+			input: "1/4 ether",
+			err:   "invalid format: rational values not permitted",
+		},
+		{
+			name:  "MagnitudeSuffix",
+			input: "2.3m ETH",
+			err:   "invalid format: magnitude suffixes not permitted",
+		},
+		{
+			name:  "UnitFirst",
+			input: "ETH 1",
+			err:   "invalid format: unit must follow the number",
+		},
+		{
+			name:  "FullWidthDigits",
+			input: "\uff11 ether",
+			err:   "invalid format: digits must be ASCII",
+		},
+		{
+			name:  "MultipleDecimalPoints",
+			input: "1.2.3 ether",
+			err:   "invalid format: number must be digits with an optional decimal point",
+		},
+		{
+			name:  "NonLetterUnit",
+			input: "1 gwei²",
+			err:   "invalid format: unit must contain only letters",
+		},
+		{
+			name:  "Fractional",
+			input: "1.5 wei",
+			err:   "value resulted in fractional number of Wei",
+		},
+		{
+			name:   "EtherSymbol",
+			input:  "1.5 Ξ",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:  "NoUnit",
+			input: "1000",
+			err:   "invalid format: unit is required",
+		},
+		{
+			name:  "NoSpace",
+			input: "1000wei",
+			err:   "invalid format: a single space is required between number and unit",
+		},
+		{
+			name:  "DoubleSpace",
+			input: "1000  wei",
+			err:   "invalid format: a single space is required between number and unit",
+		},
+		{
+			name:  "Tab",
+			input: "1000\twei",
+			err:   "invalid format: a single space is required between number and unit",
+		},
+		{
+			name:  "LeadingSpace",
+			input: " 1000 wei",
+			err:   "invalid format: leading or trailing whitespace not permitted",
+		},
+		{
+			name:  "TrailingSpace",
+			input: "1000 wei ",
+			err:   "invalid format: leading or trailing whitespace not permitted",
+		},
+		{
+			name:  "Underscore",
+			input: "1_000 wei",
+			err:   "invalid format: underscores not permitted",
+		},
+		{
+			name:  "LeadingDecimalPoint",
+			input: ".5 ether",
+			err:   "invalid format: a leading decimal point requires a zero",
+		},
+		{
+			name:  "TrailingDecimalPoint",
+			input: "5. ether",
+			err:   "invalid format: trailing decimal point not permitted",
+		},
+		{
+			name:  "UnknownUnit",
+			input: "5 foo",
+			err:   `failed to parse "5 foo" at offset 2 ("foo")`,
+		},
+		{
+			name:  "Negative",
+			input: "-5 ether",
+			err:   "value resulted in negative number of Wei",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.StringToWeiStrict(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			}
+		})
+	}
+}
+
+func TestStringToWeiStrictVersusLenient(t *testing.T) {
+	tests := []struct {
+		input  string
+		result *big.Int
+		strict bool
+	}{
+		{
+			input:  "21 Gwei",
+			result: big.NewInt(21000000000),
+			strict: true,
+		},
+		{
+			input:  "2 mega wei",
+			result: big.NewInt(2000000),
+		},
+		{
+			input:  "1_0_0 ether",
+			result: _bigInt("100000000000000000000"),
+		},
+		{
+			input:  "1  ether",
+			result: _bigInt("1000000000000000000"),
+		},
+		{
+			input:  "1 0 wei",
+			result: big.NewInt(10),
+		},
+		{
+			input:  "21gwei",
+			result: big.NewInt(21000000000),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result, err := string2eth.StringToWei(test.input)
+			require.NoError(t, err)
+			require.Equal(t, test.result, result)
+
+			result, err = string2eth.StringToWeiStrict(test.input)
+			if test.strict {
+				require.NoError(t, err)
+				require.Equal(t, test.result, result)
+			} else {
+				require.ErrorIs(t, err, string2eth.ErrInvalidFormat)
+			}
+		})
+	}
+}
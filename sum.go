@@ -0,0 +1,65 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+)
+
+// SumMismatchError is returned by SumEquals when the sum of values does not
+// match the expected total.
+type SumMismatchError struct {
+	// Expected is the expected total, in Wei.
+	Expected *big.Int
+	// Actual is the sum of the values, in Wei.
+	Actual *big.Int
+	// Difference is the actual sum less the expected total, in Wei.
+	Difference *big.Int
+}
+
+// Error implements the error interface.
+func (e *SumMismatchError) Error() string {
+	return "sum of " + e.Actual.Text(10) + " Wei does not match expected total of " + e.Expected.Text(10) +
+		" Wei (difference " + e.Difference.Text(10) + " Wei)"
+}
+
+// SumEquals sums the values and compares the result against the expected
+// total.  The expected total is parsed as per StringToWei.
+// If the sum does not match the expected total this returns false along with
+// a *SumMismatchError providing the actual sum and the exact difference.
+// Nil values are treated as 0.
+func SumEquals(values []*big.Int, expectedTotalStr string) (bool, error) {
+	expected, err := StringToWei(expectedTotalStr)
+	if err != nil {
+		return false, err
+	}
+
+	actual := new(big.Int)
+	for _, value := range values {
+		if value != nil {
+			actual.Add(actual, value)
+		}
+	}
+
+	if actual.Cmp(expected) != 0 {
+		return false, &SumMismatchError{
+			Expected:   expected,
+			Actual:     actual,
+			Difference: new(big.Int).Sub(actual, expected),
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestSumEquals(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []*big.Int
+		expected   string
+		result     bool
+		actual     *big.Int
+		difference *big.Int
+		err        string
+	}{
+		{
+			name:     "InvalidExpected",
+			values:   []*big.Int{big.NewInt(1)},
+			expected: "1 foo",
+			err:      `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+		{
+			name:     "Empty",
+			values:   []*big.Int{},
+			expected: "0",
+			result:   true,
+		},
+		{
+			name:     "Match",
+			values:   []*big.Int{_bigInt("500000000000000000"), _bigInt("1000000000000000000"), nil},
+			expected: "1.5 ether",
+			result:   true,
+		},
+		{
+			name:       "OneWeiOver",
+			values:     []*big.Int{_bigInt("500000000000000001"), _bigInt("1000000000000000000")},
+			expected:   "1.5 ether",
+			actual:     _bigInt("1500000000000000001"),
+			difference: big.NewInt(1),
+			err:        "sum of 1500000000000000001 Wei does not match expected total of 1500000000000000000 Wei (difference 1 Wei)",
+		},
+		{
+			name:       "OneWeiUnder",
+			values:     []*big.Int{_bigInt("499999999999999999"), _bigInt("1000000000000000000")},
+			expected:   "1.5 ether",
+			actual:     _bigInt("1499999999999999999"),
+			difference: big.NewInt(-1),
+			err:        "sum of 1499999999999999999 Wei does not match expected total of 1500000000000000000 Wei (difference -1 Wei)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.SumEquals(test.values, test.expected)
+			require.Equal(t, test.result, result)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				if test.difference != nil {
+					var mismatchErr *string2eth.SumMismatchError
+					require.True(t, errors.As(err, &mismatchErr))
+					require.Equal(t, test.actual, mismatchErr.Actual)
+					require.Equal(t, test.difference, mismatchErr.Difference)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
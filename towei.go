@@ -0,0 +1,131 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// ToWei turns a value in the given unit in to a number of Wei, e.g. 1.5 in
+// "ether" is 1.5*10^18 Wei.  An empty unit is Wei.
+// The value can be a string or json.Number, which is parsed as per
+// StringToWei and so must not contain its own unit if a unit is given; any
+// signed or unsigned integer type; a *big.Int; or a float64, float32 or
+// *big.Float.
+// Floating point values are converted via the shortest decimal string that
+// represents them, so a float64 of 0.1 is 0.1 rather than the binary value
+// closest to it.  Values that result in a fractional number of Wei return
+// ErrFractional, and negative values return ErrNegative.
+// Values of other types return an UnsupportedTypeError.
+//
+//nolint:cyclop
+func ToWei(value any, unit string) (*big.Int, error) {
+	multiplier, err := multiplierFor(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return stringInUnitToWei(v, unit)
+	case json.Number:
+		return stringInUnitToWei(string(v), unit)
+	case int:
+		return integerToWei(big.NewInt(int64(v)), multiplier)
+	case int8:
+		return integerToWei(big.NewInt(int64(v)), multiplier)
+	case int16:
+		return integerToWei(big.NewInt(int64(v)), multiplier)
+	case int32:
+		return integerToWei(big.NewInt(int64(v)), multiplier)
+	case int64:
+		return integerToWei(big.NewInt(v), multiplier)
+	case uint:
+		return integerToWei(new(big.Int).SetUint64(uint64(v)), multiplier)
+	case uint8:
+		return integerToWei(new(big.Int).SetUint64(uint64(v)), multiplier)
+	case uint16:
+		return integerToWei(new(big.Int).SetUint64(uint64(v)), multiplier)
+	case uint32:
+		return integerToWei(new(big.Int).SetUint64(uint64(v)), multiplier)
+	case uint64:
+		return integerToWei(new(big.Int).SetUint64(v), multiplier)
+	case *big.Int:
+		if v == nil {
+			return nil, ErrEmptyValue
+		}
+
+		return integerToWei(v, multiplier)
+	case float32:
+		return floatToWei(float64(v), 32, unit)
+	case float64:
+		return floatToWei(v, 64, unit)
+	case *big.Float:
+		if v == nil {
+			return nil, ErrEmptyValue
+		}
+		if v.IsInf() {
+			return nil, wrapError(ErrInvalidFormat, "infinite value")
+		}
+
+		return stringInUnitToWei(v.Text('f', -1), unit)
+	default:
+		return nil, &UnsupportedTypeError{Type: typeName(value)}
+	}
+}
+
+// stringInUnitToWei turns a string without a unit in to a number of Wei in
+// the given unit.
+func stringInUnitToWei(input string, unit string) (*big.Int, error) {
+	if unit == "" {
+		return StringToWei(input)
+	}
+
+	return StringToWei(input + " " + unit)
+}
+
+// typeName returns the name of the type of the value, as per the %T verb of
+// the fmt package.
+func typeName(value any) string {
+	if value == nil {
+		return "<nil>"
+	}
+
+	return reflect.TypeOf(value).String()
+}
+
+// integerToWei multiplies an integer by the multiplier to obtain a number of
+// Wei.
+func integerToWei(value *big.Int, multiplier *big.Int) (*big.Int, error) {
+	if value.Sign() < 0 {
+		return nil, ErrNegative
+	}
+
+	return new(big.Int).Mul(value, multiplier), nil
+}
+
+// floatToWei turns a floating point value with the given bit size in to a
+// number of Wei in the given unit.
+func floatToWei(value float64, bitSize int, unit string) (*big.Int, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, wrapError(ErrInvalidFormat, strconv.FormatFloat(value, 'g', -1, 64)+" is not a finite value")
+	}
+
+	return stringInUnitToWei(strconv.FormatFloat(value, 'f', -1, bitSize), unit)
+}
@@ -0,0 +1,229 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestToWei(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		unit   string
+		result *big.Int
+		err    error
+	}{
+		{
+			name:   "String",
+			value:  "1.5",
+			unit:   "ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:   "StringWithUnit",
+			value:  "1.5 ether",
+			result: _bigInt("1500000000000000000"),
+		},
+		{
+			name:  "StringWithTwoUnits",
+			value: "1.5 ether",
+			unit:  "gwei",
+			err:   string2eth.ErrParseFailure,
+		},
+		{
+			name:   "JSONNumber",
+			value:  json.Number("21"),
+			unit:   "gwei",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "Int",
+			value:  21,
+			unit:   "gwei",
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "Int8",
+			value:  int8(1),
+			unit:   "ether",
+			result: _bigInt("1000000000000000000"),
+		},
+		{
+			name:   "Int16",
+			value:  int16(1000),
+			result: big.NewInt(1000),
+		},
+		{
+			name:   "Int32",
+			value:  int32(2),
+			unit:   "kwei",
+			result: big.NewInt(2000),
+		},
+		{
+			name:   "Int64",
+			value:  int64(math.MaxInt64),
+			unit:   "ether",
+			result: _bigInt("9223372036854775807000000000000000000"),
+		},
+		{
+			name:  "NegativeInt",
+			value: -1,
+			unit:  "wei",
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:   "Uint",
+			value:  uint(3),
+			unit:   "gwei",
+			result: big.NewInt(3000000000),
+		},
+		{
+			name:   "Uint8",
+			value:  uint8(255),
+			result: big.NewInt(255),
+		},
+		{
+			name:   "Uint16",
+			value:  uint16(65535),
+			result: big.NewInt(65535),
+		},
+		{
+			name:   "Uint32",
+			value:  uint32(1),
+			unit:   "mwei",
+			result: big.NewInt(1000000),
+		},
+		{
+			name:   "Uint64",
+			value:  uint64(math.MaxUint64),
+			unit:   "gwei",
+			result: _bigInt("18446744073709551615000000000"),
+		},
+		{
+			name:   "BigInt",
+			value:  big.NewInt(5),
+			unit:   "finney",
+			result: big.NewInt(5000000000000000),
+		},
+		{
+			name:  "NilBigInt",
+			value: (*big.Int)(nil),
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:  "NegativeBigInt",
+			value: big.NewInt(-5),
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:   "Float64",
+			value:  0.1,
+			unit:   "ether",
+			result: big.NewInt(100000000000000000),
+		},
+		{
+			name:   "Float64Large",
+			value:  1e21,
+			unit:   "wei",
+			result: _bigInt("1000000000000000000000"),
+		},
+		{
+			name:  "Float64Fractional",
+			value: 0.5,
+			unit:  "wei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "Float64Negative",
+			value: -0.1,
+			unit:  "ether",
+			err:   string2eth.ErrNegative,
+		},
+		{
+			name:  "Float64NaN",
+			value: math.NaN(),
+			unit:  "ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:  "Float64Inf",
+			value: math.Inf(1),
+			unit:  "ether",
+			err:   string2eth.ErrInvalidFormat,
+		},
+		{
+			name:   "Float32",
+			value:  float32(0.1),
+			unit:   "ether",
+			result: big.NewInt(100000000000000000),
+		},
+		{
+			name:   "BigFloat",
+			value:  big.NewFloat(0.1),
+			unit:   "ether",
+			result: big.NewInt(100000000000000000),
+		},
+		{
+			name:  "BigFloatFractional",
+			value: big.NewFloat(1.5),
+			unit:  "wei",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "NilBigFloat",
+			value: (*big.Float)(nil),
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:  "UnknownUnit",
+			value: 1,
+			unit:  "foo",
+			err:   string2eth.ErrUnknownUnit,
+		},
+		{
+			name:  "Unsupported",
+			value: []int{1},
+			err:   string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := string2eth.ToWei(test.value, test.unit)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result.Text(10), result.Text(10))
+			}
+		})
+	}
+}
+
+func TestToWeiUnsupportedType(t *testing.T) {
+	_, err := string2eth.ToWei(complex(1, 0), "ether")
+	require.EqualError(t, err, "failed to parse: unsupported type complex128")
+	var typeErr *string2eth.UnsupportedTypeError
+	require.True(t, errors.As(err, &typeErr))
+	require.Equal(t, "complex128", typeErr.Type)
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+// ValidateWeiString checks that a string is a valid number of Wei, returning
+// the same error as StringToWei would for the same input.
+// Simple values, such as "21 gwei" or "1.5ether", are validated without
+// calculating the number of Wei.
+// See StringToWei for details.
+func ValidateWeiString(input string) error {
+	if _, ok := scanSimple(input); ok {
+		return nil
+	}
+	_, err := StringToWei(input)
+
+	return err
+}
+
+// IsValidWeiString returns true if a string is a valid number of Wei, that is
+// if StringToWei would parse it without error.
+// As with ValidateWeiString, simple values are checked without calculating
+// the number of Wei, making this suitable for cheap validation of input.
+func IsValidWeiString(input string) bool {
+	return ValidateWeiString(input) == nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestValidateWeiStringMatchesStringToWei(t *testing.T) {
+	for _, input := range equivalenceInputs() {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			_, expectedErr := string2eth.StringToWei(input)
+			err := string2eth.ValidateWeiString(input)
+			if expectedErr != nil {
+				require.EqualError(t, err, expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWeiString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:  "Valid",
+			input: "0.0001 kiloether",
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1 foo",
+			err:   string2eth.ErrParseFailure,
+		},
+		{
+			name:  "Fractional",
+			input: "0.0000000000000000000001 kiloether",
+			err:   string2eth.ErrFractional,
+		},
+		{
+			name:  "Negative",
+			input: "-1 ether",
+			err:   string2eth.ErrNegative,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := string2eth.ValidateWeiString(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func BenchmarkValidateWeiString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := string2eth.ValidateWeiString("1.5 ether"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestIsValidWeiStringMatchesStringToWei(t *testing.T) {
+	for _, input := range equivalenceInputs() {
+		t.Run(fmt.Sprintf("%q", input), func(t *testing.T) {
+			_, err := string2eth.StringToWei(input)
+			require.Equal(t, err == nil, string2eth.IsValidWeiString(input))
+		})
+	}
+}
+
+func TestIsValidWeiString(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+		},
+		{
+			name:  "Simple",
+			input: "21 gwei",
+			valid: true,
+		},
+		{
+			name:  "Compound",
+			input: "1 ether 500 gwei",
+			valid: true,
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1 foo",
+		},
+		{
+			name:  "Fractional",
+			input: "1.5 wei",
+		},
+		{
+			name:  "Negative",
+			input: "-1 ether",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.valid, string2eth.IsValidWeiString(test.input))
+		})
+	}
+}
+
+func BenchmarkIsValidWeiString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !string2eth.IsValidWeiString("21 gwei") {
+			b.Fatal("invalid")
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Value is the result of parsing a string.
+type Value struct {
+	// Wei is the number of Wei.
+	Wei *big.Int
+	// Unit is the canonical name of the unit in which the value was
+	// supplied, e.g. "GWei" for "5 shannon".  If no unit was supplied the
+	// unit is "Wei".
+	Unit string
+	// Number is the text of the number as supplied, e.g. "1,000.5" for
+	// "1,000.5 ether".  For a sum of values in different units it is the
+	// entire input.
+	Number string
+	// Annotation is the text of a trailing annotation ignored by
+	// ParseAnnotatedValue, e.g. "$3,902.11" for "1.5 ETH ($3,902.11)".
+	Annotation string
+}
+
+// ParseValue turns a string in to a value, retaining the unit and number as
+// supplied so that they can be reported back to the user.
+// See StringToWei for details of the accepted input.
+func ParseValue(input string) (Value, error) {
+	wei, unit, err := stringToWei(input, newParseOptions(DefaultUnitResolver))
+	if err != nil {
+		return Value{}, err
+	}
+
+	// This will never fail because the unit has already been parsed.
+	unitPos, _ := unitToMetricPos(unit)
+
+	return Value{
+		Wei:    wei,
+		Unit:   metricUnits[unitPos],
+		Number: numberText(input, unit),
+	}, nil
+}
+
+// numberText returns the text of the number in the input, given the unit as
+// returned when parsing it.
+func numberText(input string, unit string) string {
+	text := strings.TrimSpace(normaliseWhitespace(input))
+
+	// Remove trailing punctuation after the unit, as per normalisePunctuation.
+	if len(text) > 1 && strings.ContainsRune(".,;", rune(text[len(text)-1])) {
+		if r, _ := utf8.DecodeLastRuneInString(text[:len(text)-1]); unicode.IsLetter(r) {
+			text = text[:len(text)-1]
+		}
+	}
+
+	start, end := numberSpan(text, unit)
+
+	return strings.TrimSpace(text[start:end])
+}
+
+// numberSpan returns the start and end of the number in the text, given the
+// unit as returned when parsing it.  The unit is found by applying the
+// parser's normalisation to each possible unit text, so that aliases written
+// with spaces, e.g. "mega wei", and symbols, e.g. "µETH" and "Ξ", are found.
+// If the unit is not found, e.g. for a sum of values in different units, the
+// span is the entire text.
+func numberSpan(text string, unit string) (int, int) {
+	if unit == "" {
+		return 0, len(text)
+	}
+
+	// Unit after the number; the longest match is the full unit.
+	for i := range text {
+		if i > 0 && parsedUnit(text[i:]) == unit {
+			return 0, i
+		}
+	}
+	// Unit before the number.
+	for i := len(text) - 1; i > 0; i-- {
+		if utf8.RuneStart(text[i]) && parsedUnit(text[:i]) == unit {
+			return i, len(text)
+		}
+	}
+
+	return 0, len(text)
+}
+
+// parsedUnit returns the unit text as it would be returned by the parser.
+func parsedUnit(text string) string {
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, text)
+	if text == "" {
+		return ""
+	}
+
+	return replaceMicroSign(replaceEtherSymbol(text))
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+func TestParseValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		wei    *big.Int
+		unit   string
+		number string
+		err    error
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			err:   string2eth.ErrEmptyValue,
+		},
+		{
+			name:   "NoUnit",
+			input:  "1000",
+			wei:    big.NewInt(1000),
+			unit:   "Wei",
+			number: "1000",
+		},
+		{
+			name:   "GWei",
+			input:  "5 gwei",
+			wei:    big.NewInt(5000000000),
+			unit:   "GWei",
+			number: "5",
+		},
+		{
+			name:   "UnitFirst",
+			input:  "GWEI 21",
+			wei:    big.NewInt(21000000000),
+			unit:   "GWei",
+			number: "21",
+		},
+		{
+			name:   "UnitFirstNoSpace",
+			input:  "ETH1.5",
+			wei:    big.NewInt(1500000000000000000),
+			unit:   "Ether",
+			number: "1.5",
+		},
+		{
+			name:   "Shannon",
+			input:  "5 shannon",
+			wei:    big.NewInt(5000000000),
+			unit:   "GWei",
+			number: "5",
+		},
+		{
+			name:   "Finney",
+			input:  "2.5finney",
+			wei:    big.NewInt(2500000000000000),
+			unit:   "Milliether",
+			number: "2.5",
+		},
+		{
+			name:   "Grouped",
+			input:  " 1,000.5 ether ",
+			wei:    _bigInt("1000500000000000000000"),
+			unit:   "Ether",
+			number: "1,000.5",
+		},
+		{
+			name:   "TrailingPunctuation",
+			input:  "1.5 ETH.",
+			wei:    _bigInt("1500000000000000000"),
+			unit:   "Ether",
+			number: "1.5",
+		},
+		{
+			name:   "Magnitude",
+			input:  "1.5k ETH",
+			wei:    _bigInt("1500000000000000000000"),
+			unit:   "Ether",
+			number: "1.5k",
+		},
+		{
+			name:   "Hex",
+			input:  "0x10 gwei",
+			wei:    big.NewInt(16000000000),
+			unit:   "GWei",
+			number: "0x10",
+		},
+		{
+			name:   "SpacedAlias",
+			input:  "2 mega wei",
+			wei:    big.NewInt(2000000),
+			unit:   "MWei",
+			number: "2",
+		},
+		{
+			name:   "SpacedPrefixAlias",
+			input:  "5 kilo ether",
+			wei:    _bigInt("5000000000000000000000"),
+			unit:   "Kiloether",
+			number: "5",
+		},
+		{
+			name:   "MicroSign",
+			input:  "5 µETH",
+			wei:    big.NewInt(5000000000000),
+			unit:   "Microether",
+			number: "5",
+		},
+		{
+			name:   "GreekMu",
+			input:  "1.5μETH",
+			wei:    big.NewInt(1500000000000),
+			unit:   "Microether",
+			number: "1.5",
+		},
+		{
+			name:   "SymbolSuffix",
+			input:  "2.5 Ξ",
+			wei:    _bigInt("2500000000000000000"),
+			unit:   "Ether",
+			number: "2.5",
+		},
+		{
+			name:   "FullWidthDigits",
+			input:  "\uff11\uff12 gwei",
+			wei:    big.NewInt(12000000000),
+			unit:   "GWei",
+			number: "\uff11\uff12",
+		},
+		{
+			name:   "Symbol",
+			input:  "Ξ1.5",
+			wei:    _bigInt("1500000000000000000"),
+			unit:   "Ether",
+			number: "1.5",
+		},
+		{
+			name:   "Compound",
+			input:  "1 ether 500 finney",
+			wei:    _bigInt("1500000000000000000"),
+			unit:   "Ether",
+			number: "1 ether 500 finney",
+		},
+		{
+			name:  "UnknownUnit",
+			input: "1 foo",
+			err:   string2eth.ErrParseFailure,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := string2eth.ParseValue(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wei, value.Wei)
+				require.Equal(t, test.unit, value.Unit)
+				require.Equal(t, test.number, value.Number)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+)
+
+// Wei is a number of Wei that can be marshalled to and from human-readable
+// strings.
+// The zero value is 0 Wei.
+type Wei struct {
+	value *big.Int
+}
+
+// NewWei creates a Wei from a number of Wei.
+func NewWei(value *big.Int) *Wei {
+	if value == nil {
+		return &Wei{}
+	}
+
+	return &Wei{
+		value: new(big.Int).Set(value),
+	}
+}
+
+// NewWeiFromString creates a Wei from a string.
+// See StringToWei for details of the accepted input.
+func NewWeiFromString(input string) (Wei, error) {
+	value, err := StringToWei(input)
+	if err != nil {
+		return Wei{}, err
+	}
+
+	return Wei{value: value}, nil
+}
+
+// BigInt returns the number of Wei.
+func (w Wei) BigInt() *big.Int {
+	if w.value == nil {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Set(w.value)
+}
+
+// Add returns the sum of w and other.  Neither value is changed.
+func (w Wei) Add(other Wei) Wei {
+	return Wei{value: new(big.Int).Add(w.BigInt(), other.BigInt())}
+}
+
+// Sub returns the result of subtracting other from w.  Neither value is
+// changed.
+func (w Wei) Sub(other Wei) Wei {
+	return Wei{value: new(big.Int).Sub(w.BigInt(), other.BigInt())}
+}
+
+// Mul returns the result of multiplying w by scalar.  w is not changed.
+func (w Wei) Mul(scalar int64) Wei {
+	return Wei{value: new(big.Int).Mul(w.BigInt(), big.NewInt(scalar))}
+}
+
+// Cmp compares w and other, returning -1 if w is less than other, 0 if they
+// are equal and +1 if w is greater than other.
+func (w Wei) Cmp(other Wei) int {
+	return w.BigInt().Cmp(other.BigInt())
+}
+
+// String returns the canonical string representation of the number of Wei.
+func (w Wei) String() string {
+	return WeiToString(w.value, true)
+}
+
+// MarshalJSON implements json.Marshaler.
+// Negative values, which can result from Sub or Mul, return ErrNegative as
+// they cannot be unmarshalled.
+func (w Wei) MarshalJSON() ([]byte, error) {
+	if w.isNegative() {
+		return nil, ErrNegative
+	}
+
+	return json.Marshal(w.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts either a string, which is parsed as per StringToWei, or a number,
+// which is treated as a number of Wei.
+func (w *Wei) UnmarshalJSON(input []byte) error {
+	if bytes.Equal(input, []byte("null")) {
+		w.value = new(big.Int)
+
+		return nil
+	}
+
+	var str string
+	if len(input) > 0 && input[0] == '"' {
+		if err := json.Unmarshal(input, &str); err != nil {
+			return err
+		}
+	} else {
+		var number json.Number
+		if err := json.Unmarshal(input, &number); err != nil {
+			return err
+		}
+		str = number.String()
+	}
+
+	value, err := StringToWei(str)
+	if err != nil {
+		return err
+	}
+	w.value = value
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// Negative values, which can result from Sub or Mul, return ErrNegative as
+// they cannot be unmarshalled.
+func (w Wei) MarshalText() ([]byte, error) {
+	if w.isNegative() {
+		return nil, ErrNegative
+	}
+
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// The input is parsed as per StringToWei, with empty input resulting in 0 Wei.
+func (w *Wei) UnmarshalText(input []byte) error {
+	if len(input) == 0 {
+		w.value = new(big.Int)
+
+		return nil
+	}
+
+	value, err := StringToWei(string(input))
+	if err != nil {
+		return err
+	}
+	w.value = value
+
+	return nil
+}
+
+// isNegative returns true if the number of Wei is negative.
+func (w Wei) isNegative() bool {
+	return w.value != nil && w.value.Sign() < 0
+}
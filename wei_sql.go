@@ -0,0 +1,58 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !string2eth_tiny
+
+package string2eth
+
+import (
+	"database/sql/driver"
+	"math/big"
+)
+
+// Value implements driver.Valuer, returning the number of Wei as a base-10
+// integer string suitable for NUMERIC columns.
+func (w Wei) Value() (driver.Value, error) {
+	return w.BigInt().Text(10), nil
+}
+
+// Scan implements sql.Scanner.
+// It accepts the base-10 integer number of Wei as a string, byte slice or
+// integer.  NULL results in 0 Wei.
+func (w *Wei) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		w.value = new(big.Int)
+	case int64:
+		w.value = big.NewInt(v)
+	case []byte:
+		return w.scanString(string(v))
+	case string:
+		return w.scanString(v)
+	default:
+		return wrapError(ErrParseFailure, "unsupported type "+typeName(src))
+	}
+
+	return nil
+}
+
+func (w *Wei) scanString(input string) error {
+	value, success := new(big.Int).SetString(input, 10)
+	if !success {
+		return wrapErrorValue(ErrParseFailure, input)
+	}
+	w.value = value
+
+	return nil
+}
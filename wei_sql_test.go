@@ -0,0 +1,128 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !string2eth_tiny
+
+package string2eth_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+)
+
+// Ensure that Wei implements the database interfaces.
+var (
+	_ driver.Valuer = string2eth.Wei{}
+	_ sql.Scanner   = &string2eth.Wei{}
+)
+
+func TestWeiScan(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    any
+		result *big.Int
+		err    string
+	}{
+		{
+			name:   "Nil",
+			src:    nil,
+			result: big.NewInt(0),
+		},
+		{
+			name:   "Int64",
+			src:    int64(21000000000),
+			result: big.NewInt(21000000000),
+		},
+		{
+			name:   "String",
+			src:    "1500000000000000000",
+			result: big.NewInt(1500000000000000000),
+		},
+		{
+			name:   "Bytes",
+			src:    []byte("100000000000000000000000000000"),
+			result: _bigInt("100000000000000000000000000000"),
+		},
+		{
+			name: "MalformedString",
+			src:  "1.5 ether",
+			err:  "failed to parse 1.5 ether",
+		},
+		{
+			name: "MalformedBytes",
+			src:  []byte("0x10"),
+			err:  "failed to parse 0x10",
+		},
+		{
+			name: "UnsupportedType",
+			src:  1.5,
+			err:  "failed to parse: unsupported type float64",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wei := string2eth.NewWei(big.NewInt(1))
+			err := wei.Scan(test.src)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, string2eth.ErrParseFailure)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.result, wei.BigInt())
+			}
+		})
+	}
+}
+
+func TestWeiValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *big.Int
+		result driver.Value
+	}{
+		{
+			name:   "Nil",
+			result: "0",
+		},
+		{
+			name:   "GWei",
+			input:  big.NewInt(21000000000),
+			result: "21000000000",
+		},
+		{
+			name:   "Large",
+			input:  _bigInt("100000000000000000000000000000"),
+			result: "100000000000000000000000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := string2eth.NewWei(test.input).Value()
+			require.NoError(t, err)
+			require.Equal(t, test.result, value)
+
+			// Ensure that the value round-trips.
+			var wei string2eth.Wei
+			require.NoError(t, wei.Scan(value))
+			require.Equal(t, string2eth.NewWei(test.input).BigInt(), wei.BigInt())
+		})
+	}
+}
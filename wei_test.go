@@ -0,0 +1,312 @@
+// Copyright 2026 Weald Technology Trading Limited.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package string2eth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/require"
+	string2eth "github.com/wealdtech/go-string2eth"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWeiJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		value  *big.Int
+		output string
+		err    string
+	}{
+		{
+			name:   "Null",
+			input:  `null`,
+			value:  big.NewInt(0),
+			output: `"0"`,
+		},
+		{
+			name:   "Zero",
+			input:  `"0"`,
+			value:  big.NewInt(0),
+			output: `"0"`,
+		},
+		{
+			name:   "Wei",
+			input:  `"12345 wei"`,
+			value:  big.NewInt(12345),
+			output: `"12.345 KWei"`,
+		},
+		{
+			name:   "GWei",
+			input:  `"21 gwei"`,
+			value:  big.NewInt(21000000000),
+			output: `"21 GWei"`,
+		},
+		{
+			name:   "Ether",
+			input:  `"1.5 ether"`,
+			value:  big.NewInt(1500000000000000000),
+			output: `"1.5 Ether"`,
+		},
+		{
+			name:   "Kiloether",
+			input:  `"2 kiloether"`,
+			value:  _bigInt("2000000000000000000000"),
+			output: `"2000 Ether"`,
+		},
+		{
+			name:   "Number",
+			input:  `21000000000`,
+			value:  big.NewInt(21000000000),
+			output: `"21 GWei"`,
+		},
+		{
+			name:   "LargeNumber",
+			input:  `100000000000000000000000`,
+			value:  _bigInt("100000000000000000000000"),
+			output: `"100000 Ether"`,
+		},
+		{
+			name:  "FractionalNumber",
+			input: `1.5`,
+			err:   "value resulted in fractional number of Wei",
+		},
+		{
+			name:  "NegativeNumber",
+			input: `-1`,
+			err:   "value resulted in negative number of Wei",
+		},
+		{
+			name:  "InvalidString",
+			input: `"1 foo"`,
+			err:   `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+		{
+			name:  "InvalidType",
+			input: `true`,
+			err:   "json: cannot unmarshal bool into Go value of type json.Number",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var wei string2eth.Wei
+			err := json.Unmarshal([]byte(test.input), &wei)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.value, wei.BigInt())
+				output, err := json.Marshal(wei)
+				require.NoError(t, err)
+				require.Equal(t, test.output, string(output))
+
+				// Ensure that the output round-trips.
+				var roundTrip string2eth.Wei
+				require.NoError(t, json.Unmarshal(output, &roundTrip))
+				require.Equal(t, test.value, roundTrip.BigInt())
+			}
+		})
+	}
+}
+
+func TestWeiMarshalNegative(t *testing.T) {
+	a, err := string2eth.NewWeiFromString("5 wei")
+	require.NoError(t, err)
+	b, err := string2eth.NewWeiFromString("10 wei")
+	require.NoError(t, err)
+	negative := a.Sub(b)
+
+	_, err = json.Marshal(negative)
+	require.ErrorIs(t, err, string2eth.ErrNegative)
+
+	_, err = negative.MarshalText()
+	require.ErrorIs(t, err, string2eth.ErrNegative)
+
+	// Non-negative results still round-trip.
+	output, err := json.Marshal(b.Sub(a))
+	require.NoError(t, err)
+	var roundTrip string2eth.Wei
+	require.NoError(t, json.Unmarshal(output, &roundTrip))
+	require.Equal(t, big.NewInt(5), roundTrip.BigInt())
+}
+
+func TestWeiJSONStruct(t *testing.T) {
+	type config struct {
+		GasPrice *string2eth.Wei `json:"gas_price"`
+		Value    string2eth.Wei  `json:"value"`
+	}
+
+	var cfg config
+	require.NoError(t, json.Unmarshal([]byte(`{"gas_price":"21 gwei","value":"0.5 ether"}`), &cfg))
+	require.Equal(t, big.NewInt(21000000000), cfg.GasPrice.BigInt())
+	require.Equal(t, big.NewInt(500000000000000000), cfg.Value.BigInt())
+
+	output, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	require.Equal(t, `{"gas_price":"21 GWei","value":"0.5 Ether"}`, string(output))
+
+	output, err = json.Marshal(config{})
+	require.NoError(t, err)
+	require.Equal(t, `{"gas_price":null,"value":"0"}`, string(output))
+}
+
+func TestWeiText(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		value  *big.Int
+		output string
+		err    string
+	}{
+		{
+			name:   "Empty",
+			input:  "",
+			value:  big.NewInt(0),
+			output: "0",
+		},
+		{
+			name:   "Zero",
+			input:  "0",
+			value:  big.NewInt(0),
+			output: "0",
+		},
+		{
+			name:   "GWei",
+			input:  "21 gwei",
+			value:  big.NewInt(21000000000),
+			output: "21 GWei",
+		},
+		{
+			name:   "Ether",
+			input:  "1.5 ether",
+			value:  big.NewInt(1500000000000000000),
+			output: "1.5 Ether",
+		},
+		{
+			name:  "Invalid",
+			input: "1 foo",
+			err:   `failed to parse "1 foo" at offset 2 ("foo")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var wei string2eth.Wei
+			err := wei.UnmarshalText([]byte(test.input))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.value, wei.BigInt())
+				output, err := wei.MarshalText()
+				require.NoError(t, err)
+				require.Equal(t, test.output, string(output))
+			}
+		})
+	}
+}
+
+type textConfig struct {
+	GasPrice string2eth.Wei  `toml:"gas_price" yaml:"gas_price"`
+	MaxFee   *string2eth.Wei `toml:"max_fee"   yaml:"max_fee"`
+}
+
+func TestWeiYAML(t *testing.T) {
+	var cfg textConfig
+	require.NoError(t, yaml.Unmarshal([]byte("gas_price: 21 gwei\nmax_fee: 0.5 ether\n"), &cfg))
+	require.Equal(t, big.NewInt(21000000000), cfg.GasPrice.BigInt())
+	require.Equal(t, big.NewInt(500000000000000000), cfg.MaxFee.BigInt())
+
+	output, err := yaml.Marshal(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "gas_price: 21 GWei\nmax_fee: 0.5 Ether\n", string(output))
+
+	var roundTrip textConfig
+	require.NoError(t, yaml.Unmarshal(output, &roundTrip))
+	require.Equal(t, cfg.GasPrice.BigInt(), roundTrip.GasPrice.BigInt())
+	require.Equal(t, cfg.MaxFee.BigInt(), roundTrip.MaxFee.BigInt())
+
+	require.EqualError(t, yaml.Unmarshal([]byte("gas_price: 1 foo\n"), &cfg), `failed to parse "1 foo" at offset 2 ("foo")`)
+}
+
+func TestWeiTOML(t *testing.T) {
+	var cfg textConfig
+	_, err := toml.Decode("gas_price = \"21 gwei\"\nmax_fee = \"0.5 ether\"\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(21000000000), cfg.GasPrice.BigInt())
+	require.Equal(t, big.NewInt(500000000000000000), cfg.MaxFee.BigInt())
+
+	var output bytes.Buffer
+	require.NoError(t, toml.NewEncoder(&output).Encode(cfg))
+	require.Equal(t, "gas_price = \"21 GWei\"\nmax_fee = \"0.5 Ether\"\n", output.String())
+
+	var roundTrip textConfig
+	_, err = toml.Decode(output.String(), &roundTrip)
+	require.NoError(t, err)
+	require.Equal(t, cfg.GasPrice.BigInt(), roundTrip.GasPrice.BigInt())
+	require.Equal(t, cfg.MaxFee.BigInt(), roundTrip.MaxFee.BigInt())
+}
+
+func TestNewWeiFromString(t *testing.T) {
+	value, err := string2eth.NewWeiFromString("1.5 ether")
+	require.NoError(t, err)
+	require.Equal(t, "1500000000000000000", value.BigInt().String())
+
+	_, err = string2eth.NewWeiFromString("1 foo")
+	require.EqualError(t, err, `failed to parse "1 foo" at offset 2 ("foo")`)
+}
+
+func TestWeiArithmetic(t *testing.T) {
+	// Values beyond the range of int64.
+	a, err := string2eth.NewWeiFromString("100 ether")
+	require.NoError(t, err)
+	b, err := string2eth.NewWeiFromString("25.5 ether")
+	require.NoError(t, err)
+
+	require.Equal(t, "125500000000000000000", a.Add(b).BigInt().String())
+	require.Equal(t, "74500000000000000000", a.Sub(b).BigInt().String())
+	require.Equal(t, "-74500000000000000000", b.Sub(a).BigInt().String())
+	require.Equal(t, "300000000000000000000", a.Mul(3).BigInt().String())
+	require.Equal(t, "-100000000000000000000", a.Mul(-1).BigInt().String())
+	require.Equal(t, "0", a.Mul(0).BigInt().String())
+	require.Equal(t, 1, a.Cmp(b))
+	require.Equal(t, -1, b.Cmp(a))
+	require.Equal(t, 0, a.Cmp(a.Add(string2eth.Wei{})))
+
+	// Operands are unchanged.
+	require.Equal(t, "100000000000000000000", a.BigInt().String())
+	require.Equal(t, "25500000000000000000", b.BigInt().String())
+
+	// Altering the result does not alter the operands.
+	sum := a.Add(b)
+	sum.BigInt().SetInt64(0)
+	require.Equal(t, "125500000000000000000", sum.BigInt().String())
+	require.Equal(t, "100000000000000000000", a.BigInt().String())
+}
+
+func TestWeiArithmeticZeroValue(t *testing.T) {
+	var zero string2eth.Wei
+	one := *string2eth.NewWei(big.NewInt(1))
+
+	require.Equal(t, "1", zero.Add(one).BigInt().String())
+	require.Equal(t, "-1", zero.Sub(one).BigInt().String())
+	require.Equal(t, "0", zero.Mul(5).BigInt().String())
+	require.Equal(t, -1, zero.Cmp(one))
+}